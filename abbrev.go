@@ -0,0 +1,280 @@
+package main
+
+import (
+	"os"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Shorten names to fit the radio display while keeping them readable. Each step abbreviates
+// a word from the dictionary, drops the vowels from a word or, as a last resort, removes the
+// last character.
+
+const (
+	shortenSmart    = "smart"
+	shortenTruncate = "truncate"
+)
+
+// abbreviations maps lower case words or phrases to their abbreviations
+var abbreviations = map[string]string{}
+
+var builtinAbbreviations = map[string]string{
+	"North":         "N",
+	"South":         "S",
+	"East":          "E",
+	"West":          "W",
+	"Northeast":     "NE",
+	"Northwest":     "NW",
+	"Southeast":     "SE",
+	"Southwest":     "SW",
+	"Northern":      "N",
+	"Southern":      "S",
+	"Eastern":       "E",
+	"Western":       "W",
+	"Central":       "Ctl",
+	"Upper":         "Up",
+	"Lower":         "Lwr",
+	"Mount":         "Mt",
+	"Mountain":      "Mtn",
+	"Mountains":     "Mtns",
+	"Saint":         "St",
+	"Sainte":        "Ste",
+	"Fort":          "Ft",
+	"Point":         "Pt",
+	"Lake":          "Lk",
+	"Lakes":         "Lks",
+	"River":         "Rvr",
+	"Valley":        "Vly",
+	"Island":        "Isl",
+	"Islands":       "Isls",
+	"Harbor":        "Hbr",
+	"Harbour":       "Hbr",
+	"Beach":         "Bch",
+	"Falls":         "Fls",
+	"Springs":       "Spgs",
+	"Heights":       "Hts",
+	"Junction":      "Jct",
+	"Hill":          "Hl",
+	"Hills":         "Hls",
+	"Ridge":         "Rdg",
+	"Village":       "Vlg",
+	"Township":      "Twp",
+	"County":        "Co",
+	"City":          "Cty",
+	"Center":        "Ctr",
+	"Centre":        "Ctr",
+	"Statewide":     "SW",
+	"Regional":      "Rgnl",
+	"Region":        "Rgn",
+	"National":      "Natl",
+	"International": "Intl",
+	"Worldwide":     "WW",
+	"World Wide":    "WW",
+	"North America": "NA",
+	"New England":   "NewEng",
+	"English":       "Eng",
+	"Emergency":     "Emrg",
+	"Tactical":      "Tac",
+	"Repeater":      "Rptr",
+	"Association":   "Assn",
+	"University":    "Univ",
+	"Hospital":      "Hosp",
+	"Airport":       "Arpt",
+}
+
+func init() {
+	for k, v := range builtinAbbreviations {
+		abbreviations[strings.ToLower(k)] = v
+	}
+}
+
+// LoadAbbreviations adds the abbreviations in a YAML file of 'word: abbreviation' pairs
+// to the dictionary, replacing built-in ones with the same word.
+func LoadAbbreviations(fileName string) error {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return err
+	}
+	var m map[string]string
+	err = yaml.Unmarshal(data, &m)
+	if err != nil {
+		return err
+	}
+	for k, v := range m {
+		abbreviations[strings.ToLower(k)] = v
+	}
+	return nil
+}
+
+var spacesRegex = regexp.MustCompile(`\s+`)
+
+// squeeze collapses runs of white space and trims the ends
+func squeeze(s string) string {
+	return strings.TrimSpace(spacesRegex.ReplaceAllString(s, " "))
+}
+
+// shorten shrinks s until it is at most limit characters
+func shorten(s string, limit int) string {
+	if utf8.RuneCountInString(s) <= limit {
+		return s
+	}
+	s = squeeze(s)
+	for utf8.RuneCountInString(s) > limit {
+		s = shrink(s)
+	}
+	return s
+}
+
+// namePart is a piece of a name, either literal text or an interpolated value
+type namePart struct {
+	text     string
+	variable bool
+}
+
+// minPartLength is the shortest that fitName will truncate a variable part to while others
+// can still be truncated
+const minPartLength = 3
+
+// fitName joins the parts and, if the result is too long, shrinks the variable parts until
+// it fits. First the longest part that can be abbreviated or have its vowels dropped is shortened,
+// one step at a time. If that's not enough, the longest part with words in it is truncated, down
+// to minPartLength and then, sharing the cuts between the parts, down to a single character, so
+// that no part is lost while another is still several characters long. Finally the whole name
+// is truncated.
+func fitName(parts []namePart, limit int) string {
+	join := func() string {
+		var b strings.Builder
+		for _, p := range parts {
+			b.WriteString(p.text)
+		}
+		return squeeze(b.String())
+	}
+	name := join()
+	for utf8.RuneCountInString(name) > limit {
+		if !shrinkLongest(parts, abbreviateOnce) &&
+			!shrinkLongest(parts, dropVowelsOnce) &&
+			!shrinkLongest(parts, truncateWordsOnce(minPartLength)) &&
+			!shrinkLongest(parts, truncateWordsOnce(1)) {
+			return strings.TrimSpace(truncate(name, limit))
+		}
+		name = join()
+	}
+	return name
+}
+
+// shrinkLongest applies step to the longest variable part that it changes
+func shrinkLongest(parts []namePart, step func(string) string) bool {
+	longest := -1
+	var shrunk string
+	for i, p := range parts {
+		if !p.variable {
+			continue
+		}
+		s := step(squeeze(p.text))
+		if s != squeeze(p.text) &&
+			(longest < 0 || utf8.RuneCountInString(p.text) > utf8.RuneCountInString(parts[longest].text)) {
+			longest, shrunk = i, s
+		}
+	}
+	if longest < 0 {
+		return false
+	}
+	parts[longest].text = shrunk
+	return true
+}
+
+// truncateWordsOnce returns a step that removes the last character of s if it contains words
+// and is longer than min
+func truncateWordsOnce(min int) func(string) string {
+	return func(s string) string {
+		if !hasLower(s) || utf8.RuneCountInString(s) <= min {
+			return s
+		}
+		return strings.TrimSpace(truncate(s, utf8.RuneCountInString(s)-1))
+	}
+}
+
+// shrink makes one shortening step: abbreviate a word or phrase, drop the vowels from the longest
+// word or remove the last character
+func shrink(s string) string {
+	if a := abbreviateOnce(s); a != s {
+		return a
+	}
+	if d := dropVowelsOnce(s); d != s {
+		return d
+	}
+	r := []rune(s)
+	return strings.TrimSpace(string(r[:len(r)-1]))
+}
+
+// abbreviateOnce replaces the longest word or two word phrase that has an abbreviation
+func abbreviateOnce(s string) string {
+	words := strings.Split(s, " ")
+	best, bestLen, bestCount := -1, 0, 0
+	for i := range words {
+		for n := 1; n <= 2 && i+n <= len(words); n++ {
+			phrase := strings.Join(words[i:i+n], " ")
+			a, ok := abbreviations[strings.ToLower(phrase)]
+			l := utf8.RuneCountInString(phrase)
+			if ok && utf8.RuneCountInString(a) < l && l > bestLen {
+				best, bestLen, bestCount = i, l, n
+			}
+		}
+	}
+	if best < 0 {
+		return s
+	}
+	phrase := strings.Join(words[best:best+bestCount], " ")
+	words = append(words[:best], append([]string{abbreviations[strings.ToLower(phrase)]}, words[best+bestCount:]...)...)
+	return strings.Join(words, " ")
+}
+
+// dropVowelsOnce removes the lower case vowels after the first letter of the longest word that has any.
+// Words with digits or without lower case letters, like callsigns, are left alone.
+func dropVowelsOnce(s string) string {
+	words := strings.Split(s, " ")
+	best := -1
+	var bestWord string
+	for i, w := range words {
+		if !hasLower(w) || strings.ContainsFunc(w, unicode.IsDigit) {
+			continue
+		}
+		d := dropVowels(w)
+		if d != w && (best < 0 || utf8.RuneCountInString(w) > utf8.RuneCountInString(words[best])) {
+			best, bestWord = i, d
+		}
+	}
+	if best < 0 {
+		return s
+	}
+	words[best] = bestWord
+	return strings.Join(words, " ")
+}
+
+func dropVowels(w string) string {
+	var b strings.Builder
+	for i, r := range []rune(w) {
+		if i > 0 && strings.ContainsRune("aeiou", r) {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func hasLower(s string) bool {
+	return strings.ContainsFunc(s, unicode.IsLower)
+}
+
+// truncate returns at most the first n characters of s
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n])
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestShorten(t *testing.T) {
+	tests := []struct {
+		s     string
+		limit int
+		want  string
+	}{
+		{"Portland", 16, "Portland"},
+		{"Mount  Washington ", 16, "Mount Washington"},
+		{"Mount Washington", 12, "Mt Wshngtn"},
+		{"North Conway Valley", 12, "N Conway Vly"},
+		{"New England Wide", 10, "NwEng Wide"},
+		{"Portland", 4, "Prtl"},
+		{"W1IMD KQ1L", 6, "W1IMD"},
+	}
+	for _, tt := range tests {
+		got := shorten(tt.s, tt.limit)
+		if got != tt.want {
+			t.Errorf("shorten(%q, %d) = %q, want %q", tt.s, tt.limit, got, tt.want)
+		}
+	}
+}
+
+func TestFitName(t *testing.T) {
+	tests := []struct {
+		parts []namePart
+		limit int
+		want  string
+	}{
+		// Fits already
+		{[]namePart{{"Local", true}, {" 9 ", false}, {"W1IMD", true}}, 16, "Local 9 W1IMD"},
+		// Abbreviated from the dictionary
+		{[]namePart{{"New England Wide", true}, {" 3181", false}}, 16, "NewEng Wide 3181"},
+		// The longest part that can be shortened is shortened first
+		{[]namePart{{"Local", true}, {" ", false}, {"South Portland", true}}, 12, "Lcl S Prtlnd"},
+		// Truncated parts share the cuts rather than losing the last one
+		{[]namePart{{"NewEng W", true}, {" 3181 1 ", false}, {"KQ1L", true}, {" ", false}, {"Gray", true}}, 16, "N 3181 1 KQ1L Gr"},
+		{[]namePart{{"Local", true}, {" 9 2 ", false}, {"W1IMD", true}, {" ", false}, {"Portland", true}}, 16, "Lc 9 2 W1IMD Prt"},
+		// Callsigns and numbers aren't truncated, the whole name is
+		{[]namePart{{"W1IMD", true}, {" ", false}, {"3181", true}, {" 1234567", false}}, 12, "W1IMD 3181 1"},
+		{[]namePart{{"Local", true}, {" 9 2 ", false}, {"W1IMD", true}, {" ", false}, {"Portland", true}}, 10, "L 9 2 W1IM"},
+	}
+	for _, tt := range tests {
+		parts := append([]namePart(nil), tt.parts...)
+		got := fitName(parts, tt.limit)
+		if got != tt.want {
+			t.Errorf("fitName(%v, %d) = %q, want %q", tt.parts, tt.limit, got, tt.want)
+		}
+		if utf8.RuneCountInString(got) > tt.limit {
+			t.Errorf("fitName(%v, %d) = %q, which is longer than the limit", tt.parts, tt.limit, got)
+		}
+	}
+}
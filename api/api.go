@@ -0,0 +1,183 @@
+// Package api has the HTTP plumbing shared by the datasource clients. Requests are retried
+// when they fail for reasons that are likely to be temporary, like a timeout, a 429 Too Many
+// Requests or a 503 Service Unavailable, and error statuses are returned as an *HTTPError.
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultTimeout = 30 * time.Second // for each attempt, including reading the response
+	maxAttempts    = 4
+	maxRetryAfter  = 2 * time.Minute // longer Retry-After waits are reported instead
+)
+
+// The range of the backoff between attempts. They are variables so that tests can shorten them.
+var (
+	initialBackoff = time.Second
+	maxBackoff     = 30 * time.Second
+)
+
+// LevelTrace is the level of the clients' most detailed log messages, like response headers
+const LevelTrace = slog.LevelDebug - 4
+
+// DefaultClient is used by the clients that aren't given an *http.Client
+var DefaultClient = &http.Client{Timeout: DefaultTimeout}
+
+// HTTPError is returned when a service responds with an error status
+type HTTPError struct {
+	URL        string
+	StatusCode int
+	Status     string
+	RetryAfter time.Duration // zero if the response had no Retry-After header
+	Body       []byte
+}
+
+func (e *HTTPError) Error() string {
+	msg := fmt.Sprintf("HTTP request %s returned %s", e.URL, e.Status)
+	if b := e.Message(); b != "" {
+		msg += ": " + b
+	}
+	return msg
+}
+
+// Message returns the message from a JSON error response, or the start of the response body,
+// which usually explains the error
+func (e *HTTPError) Message() string {
+	var status struct {
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(e.Body, &status) == nil && status.Message != "" {
+		return status.Message
+	}
+	msg := strings.Join(strings.Fields(string(e.Body)), " ")
+	if len(msg) > 200 {
+		msg = msg[:200] + "..."
+	}
+	return msg
+}
+
+// Temporary returns true if the request might succeed if it's tried again
+func (e *HTTPError) Temporary() bool {
+	switch e.StatusCode {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusInternalServerError,
+		http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// Logger returns l, or a logger that discards everything if l is nil
+func Logger(l *slog.Logger) *slog.Logger {
+	if l == nil {
+		return slog.New(slog.NewTextHandler(io.Discard, nil))
+	}
+	return l
+}
+
+// NewRequest creates a GET request for the URL. Responses may be cached for an hour.
+func NewRequest(ctx context.Context, url string, userAgent string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating HTTP request %s: %v", url, err)
+	}
+	if userAgent != "" {
+		req.Header.Set("User-Agent", userAgent)
+	}
+	req.Header.Set("Cache-Control", "max-age=3600")
+	return req, nil
+}
+
+// Fetch sends a GET request and returns the response body. Transient failures are retried
+// with exponential backoff, waiting as long as the server asks with Retry-After. Error statuses
+// are returned as an *HTTPError.
+func Fetch(client *http.Client, req *http.Request, logger *slog.Logger) ([]byte, error) {
+	var err error
+	for attempt := 1; ; attempt++ {
+		var body []byte
+		var retryAfter time.Duration
+		body, err = fetchOnce(client, req, logger)
+		var httpErr *HTTPError
+		switch {
+		case err == nil:
+			return body, nil
+		case errors.As(err, &httpErr):
+			if !httpErr.Temporary() {
+				return nil, err
+			}
+			retryAfter = httpErr.RetryAfter
+		}
+		if attempt == maxAttempts || retryAfter > maxRetryAfter || req.Context().Err() != nil {
+			return nil, err
+		}
+		wait := backoff(attempt)
+		if retryAfter > wait {
+			wait = retryAfter
+		}
+		logger.Debug("retrying request", "error", err, "wait", wait.Round(time.Millisecond))
+		if sleepErr := sleep(req.Context(), wait); sleepErr != nil {
+			return nil, err
+		}
+	}
+}
+
+func fetchOnce(client *http.Client, req *http.Request, logger *slog.Logger) ([]byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error executing HTTP request %s: %w", req.URL, err)
+	}
+	defer resp.Body.Close()
+	if resp.Header.Get("X-From-Cache") == "1" {
+		logger.Debug("using cached response")
+	}
+	logger.Log(req.Context(), LevelTrace, "response", "status", resp.Status, "headers", resp.Header)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading HTTP response %s: %w", req.URL, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, &HTTPError{
+			URL:        req.URL.String(),
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+			Body:       body,
+		}
+	}
+	return body, nil
+}
+
+// backoff returns the wait before retrying after the given attempt: an exponentially
+// increasing delay with random jitter, so that parallel requests don't retry in lockstep
+func backoff(attempt int) time.Duration {
+	d := initialBackoff << (attempt - 1)
+	if d > maxBackoff {
+		d = maxBackoff
+	}
+	return d/2 + rand.N(d/2+1)
+}
+
+// parseRetryAfter parses a Retry-After header, which is either a number of seconds or a date
+func parseRetryAfter(s string) time.Duration {
+	if s == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(s); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(s); err == nil {
+		return max(time.Until(t), 0)
+	}
+	return 0
+}
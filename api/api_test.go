@@ -0,0 +1,210 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// shortBackoff makes retries fast for the duration of a test
+func shortBackoff(t *testing.T) {
+	savedInitial, savedMax := initialBackoff, maxBackoff
+	initialBackoff, maxBackoff = time.Millisecond, 4*time.Millisecond
+	t.Cleanup(func() { initialBackoff, maxBackoff = savedInitial, savedMax })
+}
+
+// server responds to each request with the next of statuses, repeating the last one, and
+// counts the requests
+func server(t *testing.T, header http.Header, statuses ...int) (*httptest.Server, *atomic.Int32) {
+	var count atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(count.Add(1))
+		status := statuses[min(n, len(statuses))-1]
+		for k, v := range header {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(status)
+		if status == http.StatusOK {
+			w.Write([]byte(`{"ok":true}`))
+		} else {
+			w.Write([]byte(`{"status":"error","message":"try later"}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &count
+}
+
+func fetch(t *testing.T, ctx context.Context, url string) ([]byte, error) {
+	t.Helper()
+	req, err := NewRequest(ctx, url, "dmrfill-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return Fetch(http.DefaultClient, req, Logger(nil))
+}
+
+func TestFetchSuccess(t *testing.T) {
+	srv, count := server(t, nil, http.StatusOK)
+	body, err := fetch(t, context.Background(), srv.URL)
+	if err != nil || string(body) != `{"ok":true}` {
+		t.Errorf("Fetch() = %q, %v, want %q, nil", body, err, `{"ok":true}`)
+	}
+	if count.Load() != 1 {
+		t.Errorf("got %d requests, want 1", count.Load())
+	}
+}
+
+func TestFetchRetriesServerError(t *testing.T) {
+	shortBackoff(t)
+	srv, count := server(t, nil, http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK)
+	body, err := fetch(t, context.Background(), srv.URL)
+	if err != nil || string(body) != `{"ok":true}` {
+		t.Errorf("Fetch() = %q, %v, want %q, nil", body, err, `{"ok":true}`)
+	}
+	if count.Load() != 3 {
+		t.Errorf("got %d requests, want 3", count.Load())
+	}
+}
+
+func TestFetchRetryAfter(t *testing.T) {
+	shortBackoff(t)
+	srv, count := server(t, http.Header{"Retry-After": {"1"}}, http.StatusTooManyRequests, http.StatusOK)
+	start := time.Now()
+	body, err := fetch(t, context.Background(), srv.URL)
+	if err != nil || string(body) != `{"ok":true}` {
+		t.Errorf("Fetch() = %q, %v, want %q, nil", body, err, `{"ok":true}`)
+	}
+	if count.Load() != 2 {
+		t.Errorf("got %d requests, want 2", count.Load())
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %v, want at least the 1s Retry-After", elapsed)
+	}
+}
+
+func TestFetchRetryAfterTooLong(t *testing.T) {
+	shortBackoff(t)
+	srv, count := server(t, http.Header{"Retry-After": {"3600"}}, http.StatusTooManyRequests)
+	_, err := fetch(t, context.Background(), srv.URL)
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusTooManyRequests || httpErr.RetryAfter != time.Hour {
+		t.Fatalf("Fetch() error = %v, want a 429 HTTPError with RetryAfter 1h", err)
+	}
+	if count.Load() != 1 {
+		t.Errorf("got %d requests, want 1", count.Load())
+	}
+}
+
+func TestFetchRetriesExhausted(t *testing.T) {
+	shortBackoff(t)
+	srv, count := server(t, nil, http.StatusInternalServerError)
+	_, err := fetch(t, context.Background(), srv.URL)
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("Fetch() error = %v, want a 500 HTTPError", err)
+	}
+	if httpErr.Message() != "try later" || !strings.HasSuffix(err.Error(), "returned 500 Internal Server Error: try later") {
+		t.Errorf("error = %q, want the status and message", err)
+	}
+	if count.Load() != maxAttempts {
+		t.Errorf("got %d requests, want %d", count.Load(), maxAttempts)
+	}
+}
+
+func TestFetchNotRetried(t *testing.T) {
+	shortBackoff(t)
+	srv, count := server(t, nil, http.StatusNotFound, http.StatusOK)
+	_, err := fetch(t, context.Background(), srv.URL)
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusNotFound || httpErr.Temporary() {
+		t.Fatalf("Fetch() error = %v, want a 404 HTTPError", err)
+	}
+	if count.Load() != 1 {
+		t.Errorf("got %d requests, want 1", count.Load())
+	}
+}
+
+func TestFetchCanceled(t *testing.T) {
+	// The default backoff is much longer than the context's timeout
+	srv, count := server(t, nil, http.StatusServiceUnavailable)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := fetch(t, ctx, srv.URL)
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Fetch() error = %v, want the 503 HTTPError", err)
+	}
+	if elapsed := time.Since(start); elapsed > initialBackoff/2 {
+		t.Errorf("Fetch() took %v after the context was done", elapsed)
+	}
+	if count.Load() != 1 {
+		t.Errorf("got %d requests, want 1", count.Load())
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	_, err = fetch(t, ctx, srv.URL)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Fetch() with a canceled context error = %v, want context.Canceled", err)
+	}
+	if count.Load() != 1 {
+		t.Errorf("got %d requests with a canceled context, want none", count.Load()-1)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		header   string
+		min, max time.Duration
+	}{
+		{"", 0, 0},
+		{"120", 2 * time.Minute, 2 * time.Minute},
+		{"0", 0, 0},
+		{"-5", 0, 0},
+		{"soon", 0, 0},
+		{time.Now().Add(time.Minute).UTC().Format(http.TimeFormat), 58 * time.Second, time.Minute},
+		{time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat), 0, 0},
+	}
+	for _, tt := range tests {
+		got := parseRetryAfter(tt.header)
+		if got < tt.min || got > tt.max {
+			t.Errorf("parseRetryAfter(%q) = %v, want %v to %v", tt.header, got, tt.min, tt.max)
+		}
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	for attempt := 1; attempt <= 10; attempt++ {
+		d := min(initialBackoff<<(attempt-1), maxBackoff)
+		for range 20 {
+			got := backoff(attempt)
+			if got < d/2 || got > d {
+				t.Errorf("backoff(%d) = %v, want %v to %v", attempt, got, d/2, d)
+			}
+		}
+	}
+}
+
+func TestHTTPErrorMessage(t *testing.T) {
+	tests := []struct {
+		body string
+		want string
+	}{
+		{`{"status":"error","message":"Rate limit exceeded"}`, "Rate limit exceeded"},
+		{"<html>\n  <body>Bad\tGateway</body>\n</html>", "<html> <body>Bad Gateway</body> </html>"},
+		{strings.Repeat("x", 250), strings.Repeat("x", 200) + "..."},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		e := &HTTPError{Body: []byte(tt.body)}
+		if got := e.Message(); got != tt.want {
+			t.Errorf("Message() for %q = %q, want %q", tt.body, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Limiter bounds the number of concurrent requests to a service and spaces out
+// their starts, so that parallel queries don't overload it
+type Limiter struct {
+	slots    chan struct{}
+	interval time.Duration
+	mu       sync.Mutex
+	next     time.Time // earliest start time of the next request
+}
+
+// NewLimiter returns a Limiter that allows concurrency requests at a time, starting at
+// least interval apart
+func NewLimiter(concurrency int, interval time.Duration) *Limiter {
+	return &Limiter{
+		slots:    make(chan struct{}, concurrency),
+		interval: interval,
+	}
+}
+
+// Acquire waits until a request can start. If it returns without an error, it must be
+// followed by a call to Release.
+func (l *Limiter) Acquire(ctx context.Context) error {
+	select {
+	case l.slots <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	l.mu.Lock()
+	now := time.Now()
+	start := l.next
+	if start.Before(now) {
+		start = now
+	}
+	l.next = start.Add(l.interval)
+	l.mu.Unlock()
+	err := sleep(ctx, time.Until(start))
+	if err != nil {
+		l.Release()
+	}
+	return err
+}
+
+// Release frees the slot of a request that has finished
+func (l *Limiter) Release() {
+	<-l.slots
+}
+
+// sleep waits for d, returning early with an error if ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLimiterConcurrency(t *testing.T) {
+	l := NewLimiter(2, 0)
+	var running, most atomic.Int32
+	var wg sync.WaitGroup
+	for range 6 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := l.Acquire(context.Background()); err != nil {
+				t.Error(err)
+				return
+			}
+			defer l.Release()
+			n := running.Add(1)
+			for {
+				m := most.Load()
+				if n <= m || most.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			running.Add(-1)
+		}()
+	}
+	wg.Wait()
+	if most.Load() != 2 {
+		t.Errorf("got %d concurrent requests, want 2", most.Load())
+	}
+}
+
+func TestLimiterInterval(t *testing.T) {
+	interval := 20 * time.Millisecond
+	l := NewLimiter(3, interval)
+	start := time.Now()
+	for range 3 {
+		if err := l.Acquire(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		l.Release()
+	}
+	if elapsed := time.Since(start); elapsed < 2*interval {
+		t.Errorf("3 requests started within %v, want at least %v", elapsed, 2*interval)
+	}
+}
+
+func TestLimiterCanceled(t *testing.T) {
+	l := NewLimiter(1, time.Hour)
+	if err := l.Acquire(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	// Waiting for a slot
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Acquire() error = %v, want context.DeadlineExceeded", err)
+	}
+	l.Release()
+	// Waiting for the interval, which must free the slot again
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Acquire() error = %v, want context.DeadlineExceeded", err)
+	}
+	select {
+	case l.slots <- struct{}{}:
+	default:
+		t.Error("the slot wasn't released after Acquire was canceled")
+	}
+}
@@ -1,91 +1,149 @@
 package main
 
 import (
-	"regexp"
 	"strconv"
 	"strings"
-)
 
-// Interpolate data into a string
+	"github.com/jancona/dmrfill/band"
+	"github.com/jancona/dmrfill/geo"
+	"github.com/jancona/dmrfill/radioid"
+)
 
-var argsRegex = regexp.MustCompile(`\$(\w+)(?:\:(\d+))?|([^$]+)`)
+// Interpolate data into a string, see template.go for the pattern syntax
 
 type RepeaterContext interface {
 	GetState() string
+	GetCountry() string
+	GetCounty() string
 	GetCity() string
+	GetLandmark() string
+	GetLocation() (geo.Point, bool)
+	GetCenter() (geo.Center, bool) // Proximity search center
 	GetCallsign() string
 	GetFrequency() string
+	GetOffset() (float64, bool) // Transmit offset in MHz
+	GetColorCode() string
+	GetTone() string
+	GetNetwork() string
 }
 
-func ReplaceArgs(in string, c RepeaterContext, tg *TalkGroup) string {
+func ReplaceArgs(in string, c RepeaterContext, tg *radioid.TalkGroup) string {
+	p, err := ParsePattern(in)
+	if err != nil {
+		// Patterns are checked at startup, so this shouldn't happen
+		logger.Error(err.Error())
+		return ""
+	}
+	parts := p.expand(c, tg)
+	if shortenMode == shortenSmart {
+		return fitName(parts, nameLength)
+	}
 	var b strings.Builder
+	for _, p := range parts {
+		b.WriteString(p.text)
+	}
+	// logVerbose("in: %s, expanded: %s", in, b.String())
+	return truncate(b.String(), nameLength)
+}
 
-	sm := argsRegex.FindAllStringSubmatch(in, -1)
-	for _, m := range sm {
-		if m[3] != "" {
-			// copy literal string
-			b.WriteString(m[3])
-		} else {
-			name := m[1]
-			var val string
-			if c != nil {
-				switch name {
-				case "callsign":
-					val = c.GetCallsign()
-				case "city":
-					val = c.GetCity()
-				case "frequency":
-					val = c.GetFrequency()
-				case "state":
-					val = c.GetState()
-				case "band":
-					val = band(ToFloat(c.GetFrequency()))
-				case "state_code":
-					val = states[c.GetState()]
-				}
-			}
-			if tg != nil {
-				switch name {
-				case "tg_name":
-					val = tg.Name
-				case "tg_number":
-					val = strconv.Itoa(tg.Number)
-				case "time_slot":
-					val = strconv.Itoa(tg.TimeSlot)
-				}
+// lookupVar returns the value of a pattern variable
+func lookupVar(name string, c RepeaterContext, tg *radioid.TalkGroup) string {
+	if c != nil {
+		switch name {
+		case "callsign":
+			return c.GetCallsign()
+		case "city":
+			return c.GetCity()
+		case "frequency":
+			return c.GetFrequency()
+		case "state":
+			return c.GetState()
+		case "band":
+			return band.Name(ToFloat(c.GetFrequency()))
+		case "state_code":
+			return stateCode(c)
+		case "network":
+			return c.GetNetwork()
+		case "county":
+			return c.GetCounty()
+		case "landmark":
+			return c.GetLandmark()
+		case "country_code":
+			return countryCode(c.GetCountry())
+		case "distance":
+			return distanceArg(c)
+		case "bearing":
+			return bearingArg(c)
+		case "cc":
+			return c.GetColorCode()
+		case "tone":
+			return c.GetTone()
+		case "offset_sign":
+			return offsetSign(c)
+		case "center":
+			if center, ok := c.GetCenter(); ok {
+				return center.Name
 			}
-			l, err := strconv.Atoi(m[2])
-			if err == nil && l < len(val) {
-				val = val[0:l]
-			}
-			b.WriteString(val)
 		}
 	}
-	if b.Len() > nameLength {
-		return b.String()[:nameLength]
+	if tg != nil {
+		switch name {
+		case "tg_name":
+			return tg.Name
+		case "tg_number":
+			return strconv.Itoa(tg.Number)
+		case "time_slot":
+			return strconv.Itoa(tg.TimeSlot)
+		}
 	}
-	// logVerbose("in: %s, expanded: %s", in, b.String())
-	return b.String()
+	return ""
 }
 
-func band(freq float64) string {
+// stateCode returns the subdivision code of the repeater's state, or of its region if the
+// state isn't known
+func stateCode(c RepeaterContext) string {
+	code := subdivisionCode(c.GetState(), c.GetCountry())
+	if r, ok := c.(interface{ GetRegion() string }); ok && code == "" {
+		code = subdivisionCode(r.GetRegion(), c.GetCountry())
+	}
+	return code
+}
+
+// distanceArg returns the distance from the search center in the search units
+func distanceArg(c RepeaterContext) string {
+	loc, ok := c.GetLocation()
+	center, found := c.GetCenter()
+	if !ok || !found {
+		return ""
+	}
+	d := geo.DistanceKm(center.Point, loc)
+	if radiusUnits == "miles" {
+		d = d / geo.KmPerMile
+	}
+	return strconv.FormatFloat(d, 'f', 0, 64)
+}
+
+// bearingArg returns the compass direction from the search center
+func bearingArg(c RepeaterContext) string {
+	loc, ok := c.GetLocation()
+	center, found := c.GetCenter()
+	if !ok || !found {
+		return ""
+	}
+	return geo.CompassPoint(geo.Bearing(center.Point, loc))
+}
+
+func offsetSign(c RepeaterContext) string {
+	offset, ok := c.GetOffset()
 	switch {
-	case freq >= 28.0 && freq <= 29.7:
-		return "10m"
-	case freq >= 50.0 && freq <= 54.0:
-		return "6m"
-	case freq >= 144.0 && freq <= 148.0:
-		return "2m"
-	case freq >= 220.0 && freq <= 225.0:
-		return "1.25m"
-	case freq >= 420.0 && freq <= 450.0:
-		return "70cm"
-	case freq >= 902.0 && freq <= 928.0:
-		return "33cm"
-	case freq >= 1240.0 && freq <= 1325.0:
-		return "23cm"
+	case !ok:
+		return ""
+	case offset > 0:
+		return "+"
+	case offset < 0:
+		return "-"
 	default:
-		return "UNK"
+		return "S" // simplex
 	}
 }
 
@@ -111,78 +169,3 @@ func ToFloat(freq string) float64 {
 	}
 	return f
 }
-
-var states = map[string]string{
-	"Alabama":                        "AL",
-	"Alaska":                         "AK",
-	"American Samoa":                 "AS",
-	"Arizona":                        "AZ",
-	"Arkansas":                       "AR",
-	"California":                     "CA",
-	"Colorado":                       "CO",
-	"Connecticut":                    "CT",
-	"Delaware":                       "DE",
-	"District of Columbia":           "DC",
-	"Federated States of Micronesia": "FM",
-	"Florida":                        "FL",
-	"Georgia":                        "GA",
-	"Guam":                           "GU",
-	"Hawaii":                         "HI",
-	"Idaho":                          "ID",
-	"Illinois":                       "IL",
-	"Indiana":                        "IN",
-	"Iowa":                           "IA",
-	"Kansas":                         "KS",
-	"Kentucky":                       "KY",
-	"Louisiana":                      "LA",
-	"Maine":                          "ME",
-	"Marshall Islands":               "MH",
-	"Maryland":                       "MD",
-	"Massachusetts":                  "MA",
-	"Michigan":                       "MI",
-	"Minnesota":                      "MN",
-	"Mississippi":                    "MS",
-	"Missouri":                       "MO",
-	"Montana":                        "MT",
-	"Nebraska":                       "NE",
-	"Nevada":                         "NV",
-	"New Hampshire":                  "NH",
-	"New Jersey":                     "NJ",
-	"New Mexico":                     "NM",
-	"New York":                       "NY",
-	"North Carolina":                 "NC",
-	"North Dakota":                   "ND",
-	"Northern Mariana Islands":       "MP",
-	"Ohio":                           "OH",
-	"Oklahoma":                       "OK",
-	"Oregon":                         "OR",
-	"Palau":                          "PW",
-	"Pennsylvania":                   "PA",
-	"Puerto Rico":                    "PR",
-	"Rhode Island":                   "RI",
-	"South Carolina":                 "SC",
-	"South Dakota":                   "SD",
-	"Tennessee":                      "TN",
-	"Texas":                          "TX",
-	"Utah":                           "UT",
-	"Vermont":                        "VT",
-	"Virgin Islands":                 "VI",
-	"Virginia":                       "VA",
-	"Washington":                     "WA",
-	"West Virginia":                  "WV",
-	"Wisconsin":                      "WI",
-	"Wyoming":                        "WY",
-	"Alberta":                        "AB",
-	"British Columbia":               "BC",
-	"Manitoba":                       "MB",
-	"New Brunswick":                  "NB",
-	"Newfoundland and Labrador":      "NL",
-	"Northwest Territories":          "NT",
-	"Nova Scotia":                    "NS",
-	"Nunavut":                        "NU",
-	"Ontario":                        "ON",
-	"Prince Edward Island":           "PE",
-	"Quebec":                         "QC",
-	"Saskatchewan":                   "SK",
-	"Yukon":                          "YT",
-}
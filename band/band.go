@@ -0,0 +1,25 @@
+// Package band names amateur radio bands
+package band
+
+// Name returns the name of the band that includes the frequency in MHz, like "2m", or "UNK"
+// if it isn't in a VHF or UHF amateur band
+func Name(freq float64) string {
+	switch {
+	case freq >= 28.0 && freq <= 29.7:
+		return "10m"
+	case freq >= 50.0 && freq <= 54.0:
+		return "6m"
+	case freq >= 144.0 && freq <= 148.0:
+		return "2m"
+	case freq >= 220.0 && freq <= 225.0:
+		return "1.25m"
+	case freq >= 420.0 && freq <= 450.0:
+		return "70cm"
+	case freq >= 902.0 && freq <= 928.0:
+		return "33cm"
+	case freq >= 1240.0 && freq <= 1325.0:
+		return "23cm"
+	default:
+		return "UNK"
+	}
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gregjones/httpcache"
+	"github.com/jancona/dmrfill/filter"
+	"github.com/jancona/dmrfill/geonames"
+	"github.com/jancona/dmrfill/radioid"
+	"github.com/jancona/dmrfill/repeaterbook"
+)
+
+// The datasource clients are configured from the command line options
+
+// geonamesUsername is the GeoNames account used to geocode proximity search locations
+const geonamesUsername = "dmrfill"
+
+// Filters on these fields are applied to RadioID results rather than the RepeaterBook query
+// when searching for DMR repeaters
+var radioIDOnlyFields = map[string]struct{}{
+	"network":      {},
+	"ipsc network": {},
+	"ts linked":    {},
+	"color code":   {},
+	"trustee":      {},
+}
+
+// These 'has' filter values are features of RadioID repeaters, the others of RepeaterBook ones
+var radioIDFeatures = map[string]struct{}{
+	"network": {},
+	"ts1":     {},
+	"ts2":     {},
+}
+
+var (
+	rbClient  *repeaterbook.Client
+	ridClient *radioid.Client
+)
+
+// The datasource URLs, empty for the default. The tests point them at a fixture server.
+var (
+	repeaterBookURL    string
+	repeaterBookROWURL string
+	radioIDURL         string
+	geoNamesURL        string
+)
+
+// newClients creates the datasource clients once the options have been parsed
+func newClients() {
+	rbClient = repeaterbook.NewClient(repeaterbook.Options{
+		HTTPClient: cachingHttpClient,
+		BaseURL:    repeaterBookURL,
+		ROWBaseURL: repeaterBookROWURL,
+		Token:      repeaterBookToken,
+		UserAgent:  userAgent,
+		Geocoder: geonames.NewClient(geonames.Options{
+			HTTPClient: cachingHttpClient,
+			BaseURL:    geoNamesURL,
+			Username:   geonamesUsername,
+			UserAgent:  userAgent,
+			Logger:     logger,
+		}),
+		Logger: logger,
+	})
+	ridClient = radioid.NewClient(radioid.Options{
+		HTTPClient: cachingHttpClient,
+		BaseURL:    radioIDURL,
+		UserAgent:  userAgent,
+		Logger:     logger,
+	})
+}
+
+// splitDMRFilters divides the filters of a DMR search between the RepeaterBook query and the
+// RadioID results. A 'has' filter is split by value, so 'has=ts1,ares' becomes 'has=ts1' for
+// RadioID and 'has=ares' for RepeaterBook. Other 'has' operators are applied to RepeaterBook.
+func splitDMRFilters(filters filter.Filters) (rbFilters, ridFilters filter.Filters) {
+	for _, f := range filters {
+		if _, ok := radioIDOnlyFields[f.Key]; ok {
+			ridFilters = append(ridFilters, f)
+			continue
+		}
+		if f.Key != "has" || (f.Op != "=" && f.Op != "!=") {
+			rbFilters = append(rbFilters, f)
+			continue
+		}
+		var rbValues, ridValues []string
+		for _, v := range f.Values {
+			if _, ok := radioIDFeatures[strings.ToLower(v)]; ok {
+				ridValues = append(ridValues, v)
+			} else {
+				rbValues = append(rbValues, v)
+			}
+		}
+		// The values were parsed from a valid filter, so the parts are valid too
+		if len(ridValues) > 0 {
+			ridFilters.Set(f.Key + f.Op + strings.Join(ridValues, ","))
+		}
+		if len(rbValues) > 0 {
+			rbFilters.Set(f.Key + f.Op + strings.Join(rbValues, ","))
+		}
+	}
+	return rbFilters, ridFilters
+}
+
+// queryRepeaterBook queries RepeaterBook using the command line options
+func queryRepeaterBook(ctx context.Context, filters filter.Filters) (*repeaterbook.Results, error) {
+	q := repeaterbook.Query{
+		Filters:     filters,
+		RestOfWorld: !naRepeaterBookDB,
+		Open:        open,
+		OnAir:       onAir,
+	}
+	for _, loc := range locations {
+		q.Locations = append(q.Locations, repeaterbook.Location{Place: loc.Place, RadiusKm: loc.radiusKm()})
+	}
+	return rbClient.Query(ctx, q)
+}
+
+// queryRadioID queries RadioID using the command line options. Talkgroup names are shortened
+// to the name length limit.
+func queryRadioID(ctx context.Context, filters filter.Filters) (*radioid.Results, error) {
+	result, err := ridClient.Query(ctx, radioid.Query{
+		Filters:            filters,
+		TalkgroupsRequired: talkgroupsRequired,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range result.Results {
+		for i, tg := range r.TalkGroups {
+			r.TalkGroups[i].Name = truncate(displayName(tg.Name), nameLength)
+		}
+	}
+	return result, nil
+}
+
+// successCache is an httpcache.Cache that doesn't store error responses, so that a failed
+// request that's retried isn't answered from the cache. That includes the errors RepeaterBook
+// reports with a 200 status and a body like {"status":"error","message":"..."}.
+type successCache struct {
+	httpcache.Cache
+}
+
+func (c successCache) Set(key string, responseBytes []byte) {
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(responseBytes)), nil)
+	if err != nil {
+		return
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil || resp.StatusCode < 200 || resp.StatusCode > 299 || isErrorStatus(body) {
+		c.Cache.Delete(key)
+		return
+	}
+	c.Cache.Set(key, responseBytes)
+}
+
+// isErrorStatus returns true if body is a JSON object whose status is "error"
+func isErrorStatus(body []byte) bool {
+	var status struct {
+		Status any `json:"status"`
+	}
+	return json.Unmarshal(body, &status) == nil && status.Status == "error"
+}
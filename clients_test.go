@@ -0,0 +1,53 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"strings"
+	"testing"
+
+	"github.com/gregjones/httpcache"
+)
+
+func TestSuccessCache(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		cached bool
+	}{
+		{"ok", http.StatusOK, `{"count":0,"results":[]}`, true},
+		{"not JSON", http.StatusOK, `<html></html>`, true},
+		{"other status", http.StatusOK, `{"status":{"message":"ok"}}`, true},
+		{"server error", http.StatusInternalServerError, `{"count":0}`, false},
+		{"unauthorized", http.StatusUnauthorized, `{"status":"error","message":"Invalid or missing token"}`, false},
+		{"error with 200", http.StatusOK, `{"status":"error","message":"Too many requests, rate limit exceeded"}`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{
+				StatusCode: tt.status,
+				ProtoMajor: 1,
+				ProtoMinor: 1,
+				Header:     http.Header{"Content-Type": {"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(tt.body)),
+			}
+			b, err := httputil.DumpResponse(resp, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c := successCache{httpcache.NewMemoryCache()}
+			// An earlier response for the same request
+			c.Cache.Set("key", []byte("stale"))
+			c.Set("key", b)
+			got, ok := c.Get("key")
+			if tt.cached && (!ok || string(got) != string(b)) {
+				t.Errorf("response wasn't cached")
+			}
+			if !tt.cached && ok {
+				t.Errorf("response was cached: %s", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,422 @@
+package codeplug
+
+import (
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Codeplug is a QDMR extensible codeplug, version 0.12
+type Codeplug struct {
+	Version         string                 `yaml:"version"`
+	Settings        Settings               `yaml:"settings"`
+	RadioIDs        []*RadioID             `yaml:"radioIDs"`
+	Contacts        []*Contact             `yaml:"contacts"`
+	GroupLists      []*GroupList           `yaml:"groupLists"`
+	Channels        []*Channel             `yaml:"channels"`
+	Zones           []*Zone                `yaml:"zones"`
+	ScanLists       []*ScanList            `yaml:"scanLists,omitempty"`
+	Positioning     []*Positioning         `yaml:"positioning,omitempty"`
+	RoamingChannels []*RoamingChannel      `yaml:"roamingChannels,omitempty"`
+	RoamingZones    []*RoamingZone         `yaml:"roamingZones,omitempty"`
+	SMS             *SMS                   `yaml:"sms,omitempty"`
+	Commercial      Commercial             `yaml:"commercial"`
+	Additional      map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+
+	source []byte // the YAML the codeplug was parsed from
+}
+
+// RadioID is one of the radio's own DMR IDs
+type RadioID struct {
+	Dmr struct {
+		ID         string                 `yaml:"id"`
+		Name       string                 `yaml:"name"`
+		Number     int                    `yaml:"number"`
+		Additional map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+	} `yaml:"dmr,flow"`
+	Additional map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+func (r RadioID) GetID() string {
+	return r.Dmr.ID
+}
+
+func (r RadioID) GetName() string {
+	return r.Dmr.Name
+}
+
+type Channel struct {
+	Digital    Digital                `yaml:"digital,omitempty"`
+	Analog     Analog                 `yaml:"analog,omitempty"`
+	Additional map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+func (c Channel) GetID() string {
+	if c.Analog.ID != "" {
+		return c.Analog.ID
+	}
+	return c.Digital.ID
+}
+
+func (c Channel) GetName() string {
+	if c.Analog.ID != "" {
+		return c.Analog.Name
+	}
+	return c.Digital.Name
+}
+
+func (c *Channel) SetName(name string) {
+	if c.Analog.ID != "" {
+		c.Analog.Name = name
+	} else {
+		c.Digital.Name = name
+	}
+}
+
+type Digital struct {
+	ID          string                 `yaml:"id"`
+	Name        string                 `yaml:"name"`
+	RxFrequency string                 `yaml:"rxFrequency"`
+	TxFrequency string                 `yaml:"txFrequency"`
+	RxOnly      bool                   `yaml:"rxOnly"`
+	Admit       string                 `yaml:"admit"`
+	ColorCode   int                    `yaml:"colorCode"`
+	TimeSlot    string                 `yaml:"timeSlot"`
+	RadioID     DefaultableInt         `yaml:"radioId"`
+	GroupList   string                 `yaml:"groupList"`
+	Contact     string                 `yaml:"contact"`
+	Anytone     DigitalAnytone         `yaml:"anytone"`
+	Power       DefaultableString      `yaml:"power"`
+	Timeout     DefaultableInt         `yaml:"timeout"`
+	Vox         DefaultableInt         `yaml:"vox"`
+	ScanList    string                 `yaml:"scanList,omitempty"`
+	APRS        string                 `yaml:"aprs,omitempty"`    // Positioning system ID
+	Roaming     string                 `yaml:"roaming,omitempty"` // Roaming zone ID
+	Additional  map[string]interface{} `yaml:",inline"`           // Any new keys will show up here to be roundtripped
+}
+
+// DigitalAnytone is the Anytone extension of a digital channel
+type DigitalAnytone struct {
+	Talkaround          *bool                  `yaml:"talkaround,omitempty"`
+	FrequencyCorrection *int                   `yaml:"frequencyCorrection,omitempty"`
+	HandsFree           *bool                  `yaml:"handsFree,omitempty"`
+	CallConfirm         *bool                  `yaml:"callConfirm,omitempty"`
+	Sms                 *bool                  `yaml:"sms,omitempty"`
+	SmsConfirm          *bool                  `yaml:"smsConfirm,omitempty"`
+	DataACK             *bool                  `yaml:"dataACK,omitempty"`
+	SimplexTDMA         *bool                  `yaml:"simplexTDMA,omitempty"`
+	AdaptiveTDMA        *bool                  `yaml:"adaptiveTDMA,omitempty"`
+	LoneWorker          *bool                  `yaml:"loneWorker,omitempty"`
+	ThroughMode         *bool                  `yaml:"throughMode,omitempty"`
+	Additional          map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+type Analog struct {
+	ID          string                 `yaml:"id"`
+	Name        string                 `yaml:"name"`
+	RxFrequency string                 `yaml:"rxFrequency"`
+	TxFrequency string                 `yaml:"txFrequency"`
+	RxOnly      bool                   `yaml:"rxOnly"`
+	Admit       string                 `yaml:"admit"`
+	Bandwidth   string                 `yaml:"bandwidth"`
+	Power       DefaultableString      `yaml:"power"`
+	Timeout     DefaultableInt         `yaml:"timeout"`
+	Vox         DefaultableInt         `yaml:"vox"`
+	RxTone      Tone                   `yaml:"rxTone,flow,omitempty"`
+	TxTone      Tone                   `yaml:"txTone,flow,omitempty"`
+	Squelch     DefaultableInt         `yaml:"squelch"`
+	ScanList    string                 `yaml:"scanList,omitempty"`
+	APRS        string                 `yaml:"aprs,omitempty"` // Positioning system ID
+	Anytone     *AnalogAnytone         `yaml:"anytone,omitempty"`
+	Additional  map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+// AnalogAnytone is the Anytone extension of an analog channel
+type AnalogAnytone struct {
+	Talkaround          *bool                  `yaml:"talkaround,omitempty"`
+	FrequencyCorrection *int                   `yaml:"frequencyCorrection,omitempty"`
+	HandsFree           *bool                  `yaml:"handsFree,omitempty"`
+	ReverseBurst        *bool                  `yaml:"reverseBurst,omitempty"`
+	RxCustomCTCSS       *bool                  `yaml:"rxCustomCTCSS,omitempty"`
+	TxCustomCTCSS       *bool                  `yaml:"txCustomCTCSS,omitempty"`
+	SquelchMode         string                 `yaml:"squelchMode,omitempty"`
+	Additional          map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+type Zone struct {
+	ID         string                 `yaml:"id"`
+	Name       string                 `yaml:"name"`
+	A          []string               `yaml:"A,flow"`
+	B          []string               `yaml:"B,flow"`
+	Anytone    *ZoneAnytone           `yaml:"anytone,omitempty"`
+	Additional map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+// ZoneAnytone is the Anytone extension of a zone
+type ZoneAnytone struct {
+	Hidden     *bool                  `yaml:"hidden,omitempty"`
+	Additional map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+func (z Zone) GetID() string {
+	return z.ID
+}
+
+func (z Zone) GetName() string {
+	return z.Name
+}
+
+func (z *Zone) SetName(name string) {
+	z.Name = name
+}
+
+type Tone struct {
+	CTCSS float64 `yaml:"ctcss,omitempty"`
+	DCS   float64 `yaml:"dcs,omitempty"`
+}
+
+func (t *Tone) Set(val string) error {
+	var err error
+	if strings.HasPrefix(val, "D") {
+		t.DCS, err = strconv.ParseFloat(val[1:], 64)
+	} else if val != "" {
+		t.CTCSS, err = strconv.ParseFloat(val, 64)
+	}
+	return err
+}
+
+type Contact struct {
+	DMR  DMR  `yaml:"dmr,flow,omitempty"`
+	DTMF DTMF `yaml:"dtmf,flow,omitempty"`
+}
+
+func (c Contact) GetID() string {
+	if c.DTMF.ID != "" {
+		return c.DTMF.ID
+	}
+	return c.DMR.ID
+}
+
+func (c Contact) GetName() string {
+	if c.DTMF.ID != "" {
+		return c.DTMF.Name
+	}
+	return c.DMR.Name
+}
+
+func (c *Contact) SetName(name string) {
+	if c.DTMF.ID != "" {
+		c.DTMF.Name = name
+	} else {
+		c.DMR.Name = name
+	}
+}
+
+type DMR struct {
+	ID         string                 `yaml:"id"`
+	Name       string                 `yaml:"name"`
+	Ring       bool                   `yaml:"ring"`
+	Type       string                 `yaml:"type"`
+	Number     int                    `yaml:"number"`
+	Additional map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+type DTMF struct {
+	ID         string                 `yaml:"id"`
+	Name       string                 `yaml:"name"`
+	Ring       bool                   `yaml:"ring"`
+	Number     int                    `yaml:"number"`
+	Additional map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+type GroupList struct {
+	ID         string                 `yaml:"id"`
+	Name       string                 `yaml:"name"`
+	Contacts   []string               `yaml:"contacts"`
+	Additional map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+func (g GroupList) GetID() string {
+	return g.ID
+}
+
+func (g GroupList) GetName() string {
+	return g.Name
+}
+
+func (g *GroupList) SetName(name string) {
+	g.Name = name
+}
+
+// ScanList is a list of channels to scan
+type ScanList struct {
+	ID         string                 `yaml:"id"`
+	Name       string                 `yaml:"name"`
+	Primary    string                 `yaml:"primary,omitempty"`   // Priority channel ID
+	Secondary  string                 `yaml:"secondary,omitempty"` // Second priority channel ID
+	Revert     string                 `yaml:"revert,omitempty"`    // Channel ID to transmit on
+	Channels   []string               `yaml:"channels,flow"`
+	Additional map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+func (s ScanList) GetID() string {
+	return s.ID
+}
+
+func (s ScanList) GetName() string {
+	return s.Name
+}
+
+func (s *ScanList) SetName(name string) {
+	s.Name = name
+}
+
+// RoamingChannel is a channel that's only used for roaming. The color code and time slot
+// override those of the channel roaming from it.
+type RoamingChannel struct {
+	ID          string                 `yaml:"id"`
+	Name        string                 `yaml:"name"`
+	RxFrequency string                 `yaml:"rxFrequency"`
+	TxFrequency string                 `yaml:"txFrequency"`
+	ColorCode   DefaultableInt         `yaml:"colorCode,omitempty"`
+	TimeSlot    DefaultableString      `yaml:"timeSlot,omitempty"`
+	Additional  map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+func (r RoamingChannel) GetID() string {
+	return r.ID
+}
+
+func (r RoamingChannel) GetName() string {
+	return r.Name
+}
+
+// RoamingZone is a list of channels, or roaming channels, to roam between
+type RoamingZone struct {
+	ID         string                 `yaml:"id"`
+	Name       string                 `yaml:"name"`
+	Channels   []string               `yaml:"channels"`
+	Additional map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+func (r RoamingZone) GetID() string {
+	return r.ID
+}
+
+func (r RoamingZone) GetName() string {
+	return r.Name
+}
+
+// SMS is the SMS extension, with preset messages
+type SMS struct {
+	Format     string                 `yaml:"format,omitempty"`
+	Templates  []*SMSTemplate         `yaml:"templates,omitempty"`
+	Additional map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+// SMSTemplate is a preset message
+type SMSTemplate struct {
+	ID         string                 `yaml:"id"`
+	Name       string                 `yaml:"name"`
+	Message    string                 `yaml:"message"`
+	Additional map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+func (t SMSTemplate) GetID() string {
+	return t.ID
+}
+
+func (t SMSTemplate) GetName() string {
+	return t.Name
+}
+
+// Commercial holds the commercial extension, which has the encryption keys
+type Commercial struct {
+	EncryptionKeys []*EncryptionKey       `yaml:"encryptionKeys"`
+	Additional     map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+// EncryptionKey is a DMR privacy key. Only one of Basic, Enhanced or AES is set.
+type EncryptionKey struct {
+	Basic      *Key                   `yaml:"basic,omitempty"`    // 16 bit
+	Enhanced   *Key                   `yaml:"enhanced,omitempty"` // ARC4, 40 bit
+	AES        *Key                   `yaml:"aes,omitempty"`      // 128 or 256 bit
+	Additional map[string]interface{} `yaml:",inline"`            // Any new keys will show up here to be roundtripped
+}
+
+func (e EncryptionKey) key() *Key {
+	switch {
+	case e.Basic != nil:
+		return e.Basic
+	case e.Enhanced != nil:
+		return e.Enhanced
+	case e.AES != nil:
+		return e.AES
+	}
+	return &Key{}
+}
+
+func (e EncryptionKey) GetID() string {
+	return e.key().ID
+}
+
+func (e EncryptionKey) GetName() string {
+	return e.key().Name
+}
+
+// Key is the ID, name and hex key of an encryption key
+type Key struct {
+	ID         string                 `yaml:"id"`
+	Name       string                 `yaml:"name"`
+	Key        string                 `yaml:"key"`
+	Additional map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+type DefaultableInt struct {
+	Value    int
+	HasValue bool
+}
+
+func (di *DefaultableInt) UnmarshalYAML(n *yaml.Node) error {
+	var err error
+	// logVeryVerbose("DefaultableInt, node %#v", n)
+	if n.Tag == "!default" {
+		di.HasValue = false
+	} else {
+		di.HasValue = true
+		di.Value, err = strconv.Atoi(n.Value)
+	}
+	return err
+}
+
+func (di DefaultableInt) MarshalYAML() (interface{}, error) {
+	// logVeryVerbose("DefaultableInt.MarshalYAML(): %#v", di)
+	if !di.HasValue {
+		return yaml.Node{Kind: 0x8, Style: 0x3, Tag: "!default"}, nil
+	}
+	return yaml.Node{Kind: 0x8, Style: 0x0, Tag: "!!int", Value: strconv.Itoa(di.Value)}, nil
+}
+
+type DefaultableString struct {
+	Value    string
+	HasValue bool
+}
+
+func (ds *DefaultableString) UnmarshalYAML(n *yaml.Node) error {
+	// logVeryVerbose("DefaultableString, node %#v", n)
+	if n.Tag == "!default" {
+		ds.HasValue = false
+	} else {
+		ds.HasValue = true
+		ds.Value = n.Value
+	}
+	return nil
+}
+
+func (ds DefaultableString) MarshalYAML() (interface{}, error) {
+	if !ds.HasValue {
+		return yaml.Node{Kind: yaml.ScalarNode, Style: 0x3, Tag: "!default"}, nil
+	}
+	return yaml.Node{Kind: yaml.ScalarNode, Style: 0x0, Tag: "!!str", Value: ds.Value}, nil
+}
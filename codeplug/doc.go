@@ -0,0 +1,28 @@
+// Package codeplug reads, edits and writes codeplugs in the QDMR extensible codeplug YAML
+// format (https://dm3mat.darc.de/qdmr/manual/ch03.html).
+//
+// The sections of the QDMR 0.12 format are modelled as typed fields. Keys that aren't modelled,
+// like those added by newer QDMR versions, are kept in the Additional maps so that they're
+// written back unchanged. Optional numbers and flags, like those in the settings and the Anytone
+// extensions, are pointers that are nil if the key isn't set. When a codeplug that was
+// loaded from YAML is saved, the text of the unchanged parts is kept, including comments.
+//
+//	cp, err := codeplug.Load("base.codeplug.yaml")
+//	if err != nil {
+//		return err
+//	}
+//	zone := cp.AddZone(&codeplug.Zone{Name: "Simplex"})
+//	ch := cp.AddChannel(&codeplug.Channel{
+//		Analog: codeplug.Analog{
+//			Name:        "2m Call",
+//			RxFrequency: "146.520000 MHz",
+//			TxFrequency: "146.520000 MHz",
+//		},
+//	})
+//	zone.A = append(zone.A, ch.GetID())
+//	return cp.Save("my.codeplug.yaml")
+//
+// Entities are looked up by ID with methods like Channel, Zone, Contact, GroupList and ScanList,
+// and added with new IDs by methods like AddChannel and AddScanList. Migrate upgrades a codeplug
+// in an older format to CurrentVersion.
+package codeplug
@@ -0,0 +1,189 @@
+package codeplug
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// IDer is implemented by the codeplug entities that have an ID
+type IDer interface {
+	GetID() string
+}
+
+// Named is implemented by the codeplug entities that have an ID and a name
+type Named interface {
+	IDer
+	GetName() string
+}
+
+// ToSliceOfIDer converts a slice of entities to a slice of IDers
+func ToSliceOfIDer[T IDer](s []T) []IDer {
+	result := make([]IDer, len(s))
+	for i, v := range s {
+		result[i] = v
+	}
+	return result
+}
+
+var idRegex = regexp.MustCompile(`([a-zA-Z]+)(\d+)`)
+
+// NewID returns an ID that isn't used by any of the entities, with the same prefix as their IDs
+// and a number one greater than the highest one used. If there are no entities, the ID is
+// defaultPrefix followed by 1.
+func NewID(ids []IDer, defaultPrefix string) string {
+	if len(ids) == 0 {
+		return defaultPrefix + "1"
+	}
+	var prefix string
+	var lastNumber int
+	for _, id := range ids {
+		m := idRegex.FindAllStringSubmatch(id.GetID(), -1)
+		if len(m) > 0 {
+			prefix = m[0][1]
+			// Perhaps we should detect if prefix changes?
+			n, err := strconv.Atoi(m[0][2])
+			if err == nil && n > lastNumber {
+				lastNumber = n
+			}
+
+		}
+	}
+	return fmt.Sprint(prefix, lastNumber+1)
+}
+
+// findByID returns the entity with the ID, or nil if there isn't one
+func findByID[T IDer](entities []T, id string) T {
+	for _, e := range entities {
+		if e.GetID() == id {
+			return e
+		}
+	}
+	var none T
+	return none
+}
+
+// Channel returns the channel with the ID, or nil if there isn't one
+func (cp *Codeplug) Channel(id string) *Channel {
+	return findByID(cp.Channels, id)
+}
+
+// Zone returns the zone with the ID, or nil if there isn't one
+func (cp *Codeplug) Zone(id string) *Zone {
+	return findByID(cp.Zones, id)
+}
+
+// Contact returns the contact with the ID, or nil if there isn't one
+func (cp *Codeplug) Contact(id string) *Contact {
+	return findByID(cp.Contacts, id)
+}
+
+// GroupList returns the group list with the ID, or nil if there isn't one
+func (cp *Codeplug) GroupList(id string) *GroupList {
+	return findByID(cp.GroupLists, id)
+}
+
+// ScanList returns the scan list with the ID, or nil if there isn't one
+func (cp *Codeplug) ScanList(id string) *ScanList {
+	return findByID(cp.ScanLists, id)
+}
+
+// PositioningSystem returns the positioning system with the ID, or nil if there isn't one
+func (cp *Codeplug) PositioningSystem(id string) *Positioning {
+	return findByID(cp.Positioning, id)
+}
+
+// RoamingChannel returns the roaming channel with the ID, or nil if there isn't one
+func (cp *Codeplug) RoamingChannel(id string) *RoamingChannel {
+	return findByID(cp.RoamingChannels, id)
+}
+
+// RoamingZone returns the roaming zone with the ID, or nil if there isn't one
+func (cp *Codeplug) RoamingZone(id string) *RoamingZone {
+	return findByID(cp.RoamingZones, id)
+}
+
+// EncryptionKey returns the encryption key with the ID, or nil if there isn't one
+func (cp *Codeplug) EncryptionKey(id string) *EncryptionKey {
+	return findByID(cp.Commercial.EncryptionKeys, id)
+}
+
+// ChannelName returns the name of the channel with the ID, or "" if there isn't one
+func (cp *Codeplug) ChannelName(id string) string {
+	if ch := cp.Channel(id); ch != nil {
+		return ch.GetName()
+	}
+	return ""
+}
+
+// AddChannel gives the channel a new ID and adds it to the codeplug
+func (cp *Codeplug) AddChannel(ch *Channel) *Channel {
+	id := NewID(ToSliceOfIDer(cp.Channels), "ch")
+	if ch.Analog.Name != "" || ch.Analog.RxFrequency != "" {
+		ch.Analog.ID = id
+	} else {
+		ch.Digital.ID = id
+	}
+	cp.Channels = append(cp.Channels, ch)
+	return ch
+}
+
+// AddZone gives the zone a new ID and adds it to the codeplug
+func (cp *Codeplug) AddZone(z *Zone) *Zone {
+	z.ID = NewID(ToSliceOfIDer(cp.Zones), "zone")
+	cp.Zones = append(cp.Zones, z)
+	return z
+}
+
+// AddContact gives the contact a new ID and adds it to the codeplug
+func (cp *Codeplug) AddContact(c *Contact) *Contact {
+	id := NewID(ToSliceOfIDer(cp.Contacts), "cont")
+	if c.DTMF.Name != "" || c.DTMF.Number != 0 {
+		c.DTMF.ID = id
+	} else {
+		c.DMR.ID = id
+	}
+	cp.Contacts = append(cp.Contacts, c)
+	return c
+}
+
+// AddGroupList gives the group list a new ID and adds it to the codeplug
+func (cp *Codeplug) AddGroupList(g *GroupList) *GroupList {
+	g.ID = NewID(ToSliceOfIDer(cp.GroupLists), "grp")
+	cp.GroupLists = append(cp.GroupLists, g)
+	return g
+}
+
+// AddScanList gives the scan list a new ID and adds it to the codeplug
+func (cp *Codeplug) AddScanList(s *ScanList) *ScanList {
+	s.ID = NewID(ToSliceOfIDer(cp.ScanLists), "scan")
+	cp.ScanLists = append(cp.ScanLists, s)
+	return s
+}
+
+// AddPositioning gives the positioning system a new ID and adds it to the codeplug. Either
+// DMR or APRS must be set.
+func (cp *Codeplug) AddPositioning(p *Positioning) *Positioning {
+	id := NewID(ToSliceOfIDer(cp.Positioning), "aprs")
+	if p.DMR != nil {
+		p.DMR.ID = id
+	} else if p.APRS != nil {
+		p.APRS.ID = id
+	}
+	cp.Positioning = append(cp.Positioning, p)
+	return p
+}
+
+// AddRoamingChannel gives the roaming channel a new ID and adds it to the codeplug
+func (cp *Codeplug) AddRoamingChannel(r *RoamingChannel) *RoamingChannel {
+	r.ID = NewID(ToSliceOfIDer(cp.RoamingChannels), "rc")
+	cp.RoamingChannels = append(cp.RoamingChannels, r)
+	return r
+}
+
+// AddRoamingZone gives the roaming zone a new ID and adds it to the codeplug
+func (cp *Codeplug) AddRoamingZone(r *RoamingZone) *RoamingZone {
+	r.ID = NewID(ToSliceOfIDer(cp.RoamingZones), "roam")
+	cp.RoamingZones = append(cp.RoamingZones, r)
+	return r
+}
@@ -0,0 +1,79 @@
+package codeplug
+
+// Positioning is a GPS or APRS position reporting system. Only one of DMR or APRS is set.
+type Positioning struct {
+	DMR        *DMRPositioning        `yaml:"dmr,omitempty"`
+	APRS       *APRSPositioning       `yaml:"aprs,omitempty"`
+	Additional map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+func (p Positioning) GetID() string {
+	switch {
+	case p.DMR != nil:
+		return p.DMR.ID
+	case p.APRS != nil:
+		return p.APRS.ID
+	}
+	return ""
+}
+
+func (p Positioning) GetName() string {
+	switch {
+	case p.DMR != nil:
+		return p.DMR.Name
+	case p.APRS != nil:
+		return p.APRS.Name
+	}
+	return ""
+}
+
+// DMRPositioning reports the position to a DMR contact
+type DMRPositioning struct {
+	ID         string                 `yaml:"id"`
+	Name       string                 `yaml:"name"`
+	Period     *int                   `yaml:"period,omitempty"`  // Seconds between reports
+	Contact    string                 `yaml:"contact,omitempty"` // Destination contact ID
+	Revert     string                 `yaml:"revert,omitempty"`  // Channel ID to report on
+	Additional map[string]interface{} `yaml:",inline"`           // Any new keys will show up here to be roundtripped
+}
+
+// APRSPositioning reports the position with analog APRS
+type APRSPositioning struct {
+	ID          string                 `yaml:"id"`
+	Name        string                 `yaml:"name"`
+	Period      *int                   `yaml:"period,omitempty"` // Seconds between reports
+	Revert      string                 `yaml:"revert,omitempty"` // Channel ID to report on
+	Icon        string                 `yaml:"icon,omitempty"`
+	Message     string                 `yaml:"message,omitempty"`
+	Destination string                 `yaml:"destination,omitempty"` // Callsign and SSID, like APAT81-0
+	Source      string                 `yaml:"source,omitempty"`      // Callsign and SSID
+	Path        []string               `yaml:"path,flow,omitempty"`
+	Anytone     *APRSAnytone           `yaml:"anytone,omitempty"`
+	Additional  map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+// APRSAnytone is the Anytone extension of an APRS system
+type APRSAnytone struct {
+	TxDelay        string                 `yaml:"txDelay,omitempty"`
+	PreWaveDelay   string                 `yaml:"preWaveDelay,omitempty"`
+	PassAll        *bool                  `yaml:"passAll,omitempty"`
+	ReportPosition *bool                  `yaml:"reportPosition,omitempty"`
+	ReportMicE     *bool                  `yaml:"reportMicE,omitempty"`
+	ReportObject   *bool                  `yaml:"reportObject,omitempty"`
+	ReportItem     *bool                  `yaml:"reportItem,omitempty"`
+	ReportMessage  *bool                  `yaml:"reportMessage,omitempty"`
+	ReportWeather  *bool                  `yaml:"reportWeather,omitempty"`
+	ReportNMEA     *bool                  `yaml:"reportNMEA,omitempty"`
+	ReportStatus   *bool                  `yaml:"reportStatus,omitempty"`
+	ReportOther    *bool                  `yaml:"reportOther,omitempty"`
+	Frequencies    []*APRSFrequency       `yaml:"frequencies,omitempty"`
+	Additional     map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+// APRSFrequency is one of the frequencies an Anytone radio can send APRS reports on
+type APRSFrequency struct {
+	ID         string                 `yaml:"id"`
+	Name       string                 `yaml:"name"`
+	Frequency  string                 `yaml:"frequency"`
+	Additional map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
@@ -0,0 +1,301 @@
+package codeplug
+
+import (
+	"bytes"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Writing the codeplug by encoding the Codeplug struct loses the comments, anchors and
+// formatting of the input file. Instead, Marshal keeps the input text and only
+// replaces the parts that changed. Top level sections that didn't change are copied as is.
+// In the sections that are lists of items with IDs, like zones and channels, unchanged items
+// are copied, changed items are re-encoded in place and new items are added at the end.
+
+// spliceSections are the top level sections whose items are matched by ID
+var spliceSections = map[string]func(cp *Codeplug) []IDer{
+	"contacts":        func(cp *Codeplug) []IDer { return ToSliceOfIDer(cp.Contacts) },
+	"groupLists":      func(cp *Codeplug) []IDer { return ToSliceOfIDer(cp.GroupLists) },
+	"channels":        func(cp *Codeplug) []IDer { return ToSliceOfIDer(cp.Channels) },
+	"zones":           func(cp *Codeplug) []IDer { return ToSliceOfIDer(cp.Zones) },
+	"scanLists":       func(cp *Codeplug) []IDer { return ToSliceOfIDer(cp.ScanLists) },
+	"positioning":     func(cp *Codeplug) []IDer { return ToSliceOfIDer(cp.Positioning) },
+	"roamingChannels": func(cp *Codeplug) []IDer { return ToSliceOfIDer(cp.RoamingChannels) },
+	"roamingZones":    func(cp *Codeplug) []IDer { return ToSliceOfIDer(cp.RoamingZones) },
+}
+
+// Parse decodes a codeplug in QDMR extensible codeplug YAML format
+func Parse(data []byte) (*Codeplug, error) {
+	var cp Codeplug
+	err := yaml.Unmarshal(data, &cp)
+	if err != nil {
+		return nil, err
+	}
+	cp.source = data
+	return &cp, nil
+}
+
+// Load reads a codeplug file
+func Load(fileName string) (*Codeplug, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+// Marshal returns the codeplug as YAML. If the codeplug was parsed from YAML, the text of
+// the parts that haven't changed is kept, including comments and formatting.
+func (cp *Codeplug) Marshal() ([]byte, error) {
+	return encodeCodeplug(cp.source, cp)
+}
+
+// Save writes the codeplug to a file, replacing it only once it's completely written so that
+// a failure doesn't leave a partial codeplug
+func (cp *Codeplug) Save(fileName string) error {
+	data, err := cp.Marshal()
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(fileName, data)
+}
+
+// encodeCodeplug returns the YAML for codeplug, reusing the text of source, the YAML it was
+// decoded from, wherever possible
+func encodeCodeplug(source []byte, codeplug *Codeplug) ([]byte, error) {
+	var original Codeplug
+	var doc yaml.Node
+	err := yaml.Unmarshal(source, &doc)
+	if err == nil {
+		err = yaml.Unmarshal(source, &original)
+	}
+	if err != nil || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		// Nothing to preserve
+		return encodeYAML(codeplug, 0)
+	}
+	before, err := toMappingNode(&original)
+	if err != nil {
+		return nil, err
+	}
+	after, err := toMappingNode(codeplug)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := strings.SplitAfter(string(source), "\n")
+	root := doc.Content[0]
+	var out strings.Builder
+	// Anything before the first key, like comments or a document marker
+	out.WriteString(strings.Join(lines[:root.Content[0].Line-1], ""))
+	seen := map[string]bool{}
+	for i := 0; i < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+		seen[key.Value] = true
+		start := key.Line - 1
+		next := len(lines)
+		if i+2 < len(root.Content) {
+			next = root.Content[i+2].Line - 1
+		}
+		end := contentEnd(lines, start, next)
+		section := strings.Join(lines[start:end], "")
+		trailer := strings.Join(lines[end:next], "")
+
+		newValue := mappingValue(after, key.Value)
+		switch {
+		case newValue == nil && mappingValue(before, key.Value) != nil:
+			// The section was removed, like an old section replaced by a migration
+		case newValue == nil || sameYAML(mappingValue(before, key.Value), newValue):
+			out.WriteString(section)
+		case spliceSections[key.Value] != nil && value.Kind == yaml.SequenceNode &&
+			value.Style&yaml.FlowStyle == 0 && len(value.Content) > 0:
+			s, err := spliceItems(lines[start:end], start, value, spliceSections[key.Value](&original), spliceSections[key.Value](codeplug))
+			if err != nil {
+				return nil, err
+			}
+			out.WriteString(s)
+		default:
+			s, err := encodeSection(key.Value, newValue)
+			if err != nil {
+				return nil, err
+			}
+			out.WriteString(s)
+		}
+		out.WriteString(trailer)
+	}
+	// Sections that weren't in the input go at the end, unless they are just empty defaults
+	for i := 0; i < len(after.Content); i += 2 {
+		key := after.Content[i].Value
+		if !seen[key] && !sameYAML(mappingValue(before, key), after.Content[i+1]) {
+			s, err := encodeSection(key, after.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+			if !strings.HasSuffix(out.String(), "\n") && out.Len() > 0 {
+				out.WriteString("\n")
+			}
+			out.WriteString(s)
+		}
+	}
+	result := out.String()
+	if strings.Contains(string(source), "\r\n") {
+		// Keep Windows line endings, the encoded parts only have LF
+		result = strings.ReplaceAll(strings.ReplaceAll(result, "\r\n", "\n"), "\n", "\r\n")
+	}
+	return []byte(result), nil
+}
+
+// spliceItems rebuilds a block sequence section. lines are the lines of the section, which
+// starts at line number first of the file.
+func spliceItems(lines []string, first int, seq *yaml.Node, before, after []IDer) (string, error) {
+	// The text of each original item, by ID
+	itemText := map[string]string{}
+	// An item starts at the comments before it
+	itemStart := func(n int) int {
+		start := seq.Content[n].Line - 1 - first
+		for start > 1 && strings.HasPrefix(strings.TrimSpace(lines[start-1]), "#") {
+			start--
+		}
+		return start
+	}
+	for n, item := range before {
+		if n >= len(seq.Content) {
+			break
+		}
+		end := len(lines)
+		if n+1 < len(seq.Content) {
+			end = itemStart(n + 1)
+		}
+		itemText[item.GetID()] = strings.Join(lines[itemStart(n):end], "")
+	}
+	dash := lines[seq.Content[0].Line-1-first]
+	indent := len(dash) - len(strings.TrimLeft(dash, " "))
+	originals := map[string]IDer{}
+	for _, item := range before {
+		originals[item.GetID()] = item
+	}
+
+	var out strings.Builder
+	// The key and any comments before the first item
+	out.WriteString(strings.Join(lines[:itemStart(0)], ""))
+	for _, item := range after {
+		text, ok := itemText[item.GetID()]
+		if !ok || !sameYAML(originals[item.GetID()], item) {
+			b, err := encodeYAML([]IDer{item}, indent)
+			if err != nil {
+				return "", err
+			}
+			text = string(b)
+		}
+		// The last line of the file may not end with a newline
+		if out.Len() > 0 && !strings.HasSuffix(out.String(), "\n") {
+			out.WriteString("\n")
+		}
+		out.WriteString(text)
+	}
+	return out.String(), nil
+}
+
+// contentEnd returns the line after the last line of a section's content, so that blank lines
+// and unindented comments before the next section stay with it
+func contentEnd(lines []string, start, next int) int {
+	end := next
+	for end > start+1 {
+		line := lines[end-1]
+		if strings.TrimSpace(line) != "" && !strings.HasPrefix(line, "#") {
+			break
+		}
+		end--
+	}
+	return end
+}
+
+func toMappingNode(v any) (*yaml.Node, error) {
+	var n yaml.Node
+	err := n.Encode(v)
+	if err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+// mappingValue returns the value for key in a mapping node, or nil if it isn't there
+func mappingValue(m *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			return m.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// sameYAML returns true if a and b encode to the same YAML
+func sameYAML(a, b any) bool {
+	ya, errA := yaml.Marshal(a)
+	yb, errB := yaml.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(ya, yb)
+}
+
+func encodeSection(key string, value *yaml.Node) (string, error) {
+	b, err := encodeYAML(&yaml.Node{
+		Kind:    yaml.MappingNode,
+		Content: []*yaml.Node{{Kind: yaml.ScalarNode, Value: key}, value},
+	}, 0)
+	return string(b), err
+}
+
+// encodeYAML encodes v the way QDMR writes codeplugs, indenting each line by indent spaces
+func encodeYAML(v any, indent int) ([]byte, error) {
+	var b bytes.Buffer
+	encoder := yaml.NewEncoder(&b)
+	encoder.SetIndent(2)
+	err := encoder.Encode(v)
+	if err != nil {
+		return nil, err
+	}
+	encoder.Close()
+	if indent == 0 {
+		return b.Bytes(), nil
+	}
+	prefix := strings.Repeat(" ", indent)
+	var out bytes.Buffer
+	for _, line := range strings.SplitAfter(b.String(), "\n") {
+		if strings.TrimSpace(line) != "" {
+			out.WriteString(prefix)
+		}
+		out.WriteString(line)
+	}
+	return out.Bytes(), nil
+}
+
+// writeFileAtomic replaces the file with data by writing a temporary file and renaming it. An
+// existing file keeps its permissions.
+func writeFileAtomic(fileName string, data []byte) error {
+	mode := fs.FileMode(0644)
+	if info, err := os.Stat(fileName); err == nil {
+		mode = info.Mode().Perm()
+	}
+	f, err := os.CreateTemp(filepath.Dir(fileName), "."+filepath.Base(fileName)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	// Removing the temporary file fails harmlessly once it has been renamed
+	defer os.Remove(f.Name())
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(f.Name(), mode)
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), fileName)
+}
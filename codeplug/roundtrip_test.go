@@ -0,0 +1,147 @@
+package codeplug
+
+import (
+	"strings"
+	"testing"
+)
+
+const roundTripSource = `# base codeplug
+zones:
+  - id: zone1
+    name: Zone 1
+    A: [ch1]
+channels:
+  - analog:
+      id: ch1
+      name: 2m Call
+      rxFrequency: 146.520000 MHz
+      txFrequency: 146.520000 MHz
+`
+
+// addZone adds a zone with a new channel to a parsed codeplug, marshals it and parses the
+// result again
+func addZone(t *testing.T, source string) (string, *Codeplug) {
+	t.Helper()
+	cp, err := Parse([]byte(source))
+	if err != nil {
+		t.Fatal(err)
+	}
+	zone := cp.AddZone(&Zone{Name: "Zone 2"})
+	ch := cp.AddChannel(&Channel{Analog: Analog{Name: "70cm Call", RxFrequency: "446.000000 MHz", TxFrequency: "446.000000 MHz"}})
+	zone.A = append(zone.A, ch.GetID())
+	out, err := cp.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := Parse(out)
+	if err != nil {
+		t.Fatalf("can't parse the output: %v\n%s", err, out)
+	}
+	return string(out), again
+}
+
+func checkAdded(t *testing.T, out string, cp *Codeplug) {
+	t.Helper()
+	if len(cp.Zones) != 2 || len(cp.Channels) != 2 {
+		t.Fatalf("got %d zones and %d channels, want 2 and 2:\n%s", len(cp.Zones), len(cp.Channels), out)
+	}
+	if z := cp.Zone("zone2"); z == nil || z.Name != "Zone 2" || len(z.A) != 1 || z.A[0] != "ch2" {
+		t.Errorf("zone2 wasn't added:\n%s", out)
+	}
+	if !strings.HasPrefix(out, "# base codeplug") {
+		t.Errorf("comment wasn't kept:\n%s", out)
+	}
+}
+
+func TestMarshalAddsItems(t *testing.T) {
+	out, cp := addZone(t, roundTripSource)
+	checkAdded(t, out, cp)
+	if !strings.HasPrefix(out, roundTripSource[:strings.Index(roundTripSource, "channels:")]) {
+		t.Errorf("unchanged zone text wasn't kept:\n%s", out)
+	}
+}
+
+func TestMarshalNoTrailingNewline(t *testing.T) {
+	// The zones are last, so new zones follow the last line of the file
+	zones := roundTripSource[strings.Index(roundTripSource, "zones:"):strings.Index(roundTripSource, "channels:")]
+	channels := roundTripSource[strings.Index(roundTripSource, "channels:"):]
+	out, cp := addZone(t, "# base codeplug\n"+channels+strings.TrimSuffix(zones, "\n"))
+	checkAdded(t, out, cp)
+}
+
+func TestMarshalCRLF(t *testing.T) {
+	source := strings.ReplaceAll(roundTripSource, "\n", "\r\n")
+	out, cp := addZone(t, source)
+	checkAdded(t, out, cp)
+	if strings.Count(out, "\n") != strings.Count(out, "\r\n") {
+		t.Errorf("output mixes line endings:\n%q", out)
+	}
+	out, cp = addZone(t, strings.TrimSuffix(source, "\r\n"))
+	checkAdded(t, out, cp)
+}
+
+func TestMarshalUnchanged(t *testing.T) {
+	for _, source := range []string{roundTripSource, strings.TrimSuffix(roundTripSource, "\n"),
+		strings.ReplaceAll(roundTripSource, "\n", "\r\n")} {
+		cp, err := Parse([]byte(source))
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := cp.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(out) != source {
+			t.Errorf("unchanged codeplug was rewritten:\ngot:  %q\nwant: %q", out, source)
+		}
+	}
+}
+
+// Zero and false aren't QDMR's defaults for every setting, so they must be kept when the
+// settings and channels are re-encoded
+func TestMarshalKeepsZeroValues(t *testing.T) {
+	source := `settings:
+  introLine1: Hello
+  micLevel: 0
+  squelch: 0
+  vox: 0
+channels:
+  - digital:
+      id: ch1
+      name: Local
+      rxFrequency: 444.200000 MHz
+      txFrequency: 449.200000 MHz
+      anytone:
+        sms: false
+        dataACK: false
+  - analog:
+      id: ch2
+      name: 2m Call
+      rxFrequency: 146.520000 MHz
+      txFrequency: 146.520000 MHz
+      anytone:
+        talkaround: false
+`
+	cp, err := Parse([]byte(source))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cp.Settings.IntroLine1 = "Hi"
+	cp.Channel("ch1").SetName("Local TS1")
+	cp.Channel("ch2").SetName("Calling")
+	out, err := cp.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"introLine1: Hi", "micLevel: 0", "squelch: 0", "vox: 0", "name: Local TS1",
+		"sms: false", "dataACK: false", "name: Calling", "talkaround: false"} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("output doesn't contain %q:\n%s", want, out)
+		}
+	}
+	for _, unset := range []string{"speech", "tot", "callConfirm", "handsFree"} {
+		if strings.Contains(string(out), unset+":") {
+			t.Errorf("output has %s, which wasn't set:\n%s", unset, out)
+		}
+	}
+}
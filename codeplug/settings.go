@@ -0,0 +1,264 @@
+package codeplug
+
+// The settings section. QDMR's defaults aren't always the zero value, the squelch defaults to
+// 1 for example, so numbers and flags are pointers. They're nil if the key isn't in the codeplug,
+// and are written back as they were, including zeros and false.
+
+// Settings are the general radio settings
+type Settings struct {
+	IntroLine1 string                 `yaml:"introLine1,omitempty"`
+	IntroLine2 string                 `yaml:"introLine2,omitempty"`
+	MicLevel   *int                   `yaml:"micLevel,omitempty"`
+	Speech     *bool                  `yaml:"speech,omitempty"`
+	Power      string                 `yaml:"power,omitempty"`
+	Squelch    *int                   `yaml:"squelch,omitempty"`
+	Vox        *int                   `yaml:"vox,omitempty"`
+	Tot        *int                   `yaml:"tot,omitempty"`
+	Anytone    *AnytoneSettings       `yaml:"anytone,omitempty"`
+	DefaultID  string                 `yaml:"defaultID,omitempty"`
+	Additional map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+// AnytoneSettings is the Anytone extension of the settings
+type AnytoneSettings struct {
+	SubChannel              *bool                           `yaml:"subChannel,omitempty"`
+	SelectedVFO             string                          `yaml:"selectedVFO,omitempty"`
+	ModeA                   string                          `yaml:"modeA,omitempty"`
+	ModeB                   string                          `yaml:"modeB,omitempty"`
+	VfoScanType             string                          `yaml:"vfoScanType,omitempty"`
+	MinVFOScanFrequencyUHF  string                          `yaml:"minVFOScanFrequencyUHF,omitempty"`
+	MaxVFOScanFrequencyUHF  string                          `yaml:"maxVFOScanFrequencyUHF,omitempty"`
+	MinVFOScanFrequencyVHF  string                          `yaml:"minVFOScanFrequencyVHF,omitempty"`
+	MaxVFOScanFrequencyVHF  string                          `yaml:"maxVFOScanFrequencyVHF,omitempty"`
+	KeepLastCaller          *bool                           `yaml:"keepLastCaller,omitempty"`
+	VfoStep                 string                          `yaml:"vfoStep,omitempty"`
+	SteType                 string                          `yaml:"steType,omitempty"`
+	SteFrequency            *int                            `yaml:"steFrequency,omitempty"`
+	SteDuration             string                          `yaml:"steDuration,omitempty"`
+	TbstFrequency           string                          `yaml:"tbstFrequency,omitempty"`
+	ProMode                 *bool                           `yaml:"proMode,omitempty"`
+	MaintainCallChannel     *bool                           `yaml:"maintainCallChannel,omitempty"`
+	BootSettings            *AnytoneBootSettings            `yaml:"bootSettings,omitempty"`
+	PowerSaveSettings       *AnytonePowerSaveSettings       `yaml:"powerSaveSettings,omitempty"`
+	KeySettings             *AnytoneKeySettings             `yaml:"keySettings,omitempty"`
+	ToneSettings            *AnytoneToneSettings            `yaml:"toneSettings,omitempty"`
+	DisplaySettings         *AnytoneDisplaySettings         `yaml:"displaySettings,omitempty"`
+	AudioSettings           *AnytoneAudioSettings           `yaml:"audioSettings,omitempty"`
+	MenuSettings            *AnytoneMenuSettings            `yaml:"menuSettings,omitempty"`
+	AutoRepeaterSettings    *AnytoneAutoRepeaterSettings    `yaml:"autoRepeaterSettings,omitempty"`
+	DmrSettings             *AnytoneDmrSettings             `yaml:"dmrSettings,omitempty"`
+	GpsSettings             *AnytoneGpsSettings             `yaml:"gpsSettings,omitempty"`
+	RoamingSettings         *AnytoneRoamingSettings         `yaml:"roamingSettings,omitempty"`
+	BluetoothSettings       *AnytoneBluetoothSettings       `yaml:"bluetoothSettings,omitempty"`
+	SimplexRepeaterSettings *AnytoneSimplexRepeaterSettings `yaml:"simplexRepeaterSettings,omitempty"`
+	Additional              map[string]interface{}          `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+// AnytoneBootSettings are the boot settings of the Anytone extension
+type AnytoneBootSettings struct {
+	BootDisplay         string                 `yaml:"bootDisplay,omitempty"`
+	BootPasswordEnabled *bool                  `yaml:"bootPasswordEnabled,omitempty"`
+	BootPassword        string                 `yaml:"bootPassword,omitempty"`
+	DefaultChannel      *bool                  `yaml:"defaultChannel,omitempty"`
+	GpsCheck            *bool                  `yaml:"gpsCheck,omitempty"`
+	Reset               *bool                  `yaml:"reset,omitempty"`
+	Additional          map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+// AnytonePowerSaveSettings are the power save settings of the Anytone extension
+type AnytonePowerSaveSettings struct {
+	AutoShutdown            *int                   `yaml:"autoShutdown,omitempty"`
+	ResetAutoShutdownOnCall *bool                  `yaml:"resetAutoShutdownOnCall,omitempty"`
+	PowerSave               string                 `yaml:"powerSave,omitempty"`
+	Atpc                    *bool                  `yaml:"atpc,omitempty"`
+	Additional              map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+// AnytoneKeySettings are the key settings of the Anytone extension
+type AnytoneKeySettings struct {
+	FuncKey1Short     string                 `yaml:"funcKey1Short,omitempty"`
+	FuncKey1Long      string                 `yaml:"funcKey1Long,omitempty"`
+	FuncKey2Short     string                 `yaml:"funcKey2Short,omitempty"`
+	FuncKey2Long      string                 `yaml:"funcKey2Long,omitempty"`
+	FuncKey3Short     string                 `yaml:"funcKey3Short,omitempty"`
+	FuncKey3Long      string                 `yaml:"funcKey3Long,omitempty"`
+	FuncKey4Short     string                 `yaml:"funcKey4Short,omitempty"`
+	FuncKey4Long      string                 `yaml:"funcKey4Long,omitempty"`
+	FuncKey5Short     string                 `yaml:"funcKey5Short,omitempty"`
+	FuncKey5Long      string                 `yaml:"funcKey5Long,omitempty"`
+	FuncKey6Short     string                 `yaml:"funcKey6Short,omitempty"`
+	FuncKey6Long      string                 `yaml:"funcKey6Long,omitempty"`
+	FuncKeyAShort     string                 `yaml:"funcKeyAShort,omitempty"`
+	FuncKeyALong      string                 `yaml:"funcKeyALong,omitempty"`
+	FuncKeyBShort     string                 `yaml:"funcKeyBShort,omitempty"`
+	FuncKeyBLong      string                 `yaml:"funcKeyBLong,omitempty"`
+	FuncKeyCShort     string                 `yaml:"funcKeyCShort,omitempty"`
+	FuncKeyCLong      string                 `yaml:"funcKeyCLong,omitempty"`
+	FuncKeyDShort     string                 `yaml:"funcKeyDShort,omitempty"`
+	FuncKeyDLong      string                 `yaml:"funcKeyDLong,omitempty"`
+	LongPressDuration string                 `yaml:"longPressDuration,omitempty"`
+	AutoKeyLock       *bool                  `yaml:"autoKeyLock,omitempty"`
+	KnobLock          *bool                  `yaml:"knobLock,omitempty"`
+	KeypadLock        *bool                  `yaml:"keypadLock,omitempty"`
+	SideKeysLock      *bool                  `yaml:"sideKeysLock,omitempty"`
+	ForcedKeyLock     *bool                  `yaml:"forcedKeyLock,omitempty"`
+	Additional        map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+// AnytoneToneSettings are the tone settings of the Anytone extension
+type AnytoneToneSettings struct {
+	KeyTone       *bool                  `yaml:"keyTone,omitempty"`
+	KeyToneLevel  *int                   `yaml:"keyToneLevel,omitempty"`
+	SmsAlert      *bool                  `yaml:"smsAlert,omitempty"`
+	CallAlert     *bool                  `yaml:"callAlert,omitempty"`
+	DmrTalkPermit *bool                  `yaml:"dmrTalkPermit,omitempty"`
+	DmrReset      *bool                  `yaml:"dmrReset,omitempty"`
+	FmTalkPermit  *bool                  `yaml:"fmTalkPermit,omitempty"`
+	DmrIdle       *bool                  `yaml:"dmrIdle,omitempty"`
+	FmIdle        *bool                  `yaml:"fmIdle,omitempty"`
+	Startup       *bool                  `yaml:"startup,omitempty"`
+	Tot           *bool                  `yaml:"tot,omitempty"`
+	CallMelody    *Melody                `yaml:"callMelody,omitempty"`
+	IdleMelody    *Melody                `yaml:"idleMelody,omitempty"`
+	ResetMelody   *Melody                `yaml:"resetMelody,omitempty"`
+	CallEndMelody *Melody                `yaml:"callEndMelody,omitempty"`
+	Additional    map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+// Melody is a tune played by the radio
+type Melody struct {
+	Bpm        *int                   `yaml:"bpm,omitempty"`
+	Melody     string                 `yaml:"melody,omitempty"`
+	Additional map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+// AnytoneDisplaySettings are the display settings of the Anytone extension
+type AnytoneDisplaySettings struct {
+	DisplayFrequency        *bool                  `yaml:"displayFrequency,omitempty"`
+	Brightness              *int                   `yaml:"brightness,omitempty"`
+	BacklightDuration       *int                   `yaml:"backlightDuration,omitempty"`
+	BacklightDurationTX     *int                   `yaml:"backlightDurationTX,omitempty"`
+	BacklightDurationRX     *int                   `yaml:"backlightDurationRX,omitempty"`
+	CustomChannelBackground *bool                  `yaml:"customChannelBackground,omitempty"`
+	VolumeChangePrompt      *bool                  `yaml:"volumeChangePrompt,omitempty"`
+	CallEndPrompt           *bool                  `yaml:"callEndPrompt,omitempty"`
+	ShowClock               *bool                  `yaml:"showClock,omitempty"`
+	ShowCall                *bool                  `yaml:"showCall,omitempty"`
+	ShowContact             *bool                  `yaml:"showContact,omitempty"`
+	ShowChannelNumber       *bool                  `yaml:"showChannelNumber,omitempty"`
+	ShowColorCode           *bool                  `yaml:"showColorCode,omitempty"`
+	ShowTimeSlot            *bool                  `yaml:"showTimeSlot,omitempty"`
+	ShowChannelType         *bool                  `yaml:"showChannelType,omitempty"`
+	ShowLastHeard           *bool                  `yaml:"showLastHeard,omitempty"`
+	LastCallerDisplay       string                 `yaml:"lastCallerDisplay,omitempty"`
+	CallColor               string                 `yaml:"callColor,omitempty"`
+	StandbyTextColor        string                 `yaml:"standbyTextColor,omitempty"`
+	StandbyBackgroundColor  string                 `yaml:"standbyBackgroundColor,omitempty"`
+	ChannelNameColor        string                 `yaml:"channelNameColor,omitempty"`
+	ChannelBNameColor       string                 `yaml:"channelBNameColor,omitempty"`
+	ZoneNameColor           string                 `yaml:"zoneNameColor,omitempty"`
+	ZoneBNameColor          string                 `yaml:"zoneBNameColor,omitempty"`
+	Language                string                 `yaml:"language,omitempty"`
+	DateFormat              string                 `yaml:"dateFormat,omitempty"`
+	Additional              map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+// AnytoneAudioSettings are the audio settings of the Anytone extension
+type AnytoneAudioSettings struct {
+	VoxDelay           string                 `yaml:"voxDelay,omitempty"`
+	VoxSource          string                 `yaml:"voxSource,omitempty"`
+	Recording          *bool                  `yaml:"recording,omitempty"`
+	Enhance            *bool                  `yaml:"enhance,omitempty"`
+	MuteDelay          string                 `yaml:"muteDelay,omitempty"`
+	MaxVolume          *int                   `yaml:"maxVolume,omitempty"`
+	MaxHeadPhoneVolume *int                   `yaml:"maxHeadPhoneVolume,omitempty"`
+	EnableFMMicGain    *bool                  `yaml:"enableFMMicGain,omitempty"`
+	FmMicGain          *int                   `yaml:"fmMicGain,omitempty"`
+	Additional         map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+// AnytoneMenuSettings are the menu settings of the Anytone extension
+type AnytoneMenuSettings struct {
+	Duration   string                 `yaml:"duration,omitempty"`
+	Separator  *bool                  `yaml:"separator,omitempty"`
+	Additional map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+// AnytoneAutoRepeaterSettings are the auto repeater settings of the Anytone extension
+type AnytoneAutoRepeaterSettings struct {
+	DirectionA string                 `yaml:"directionA,omitempty"`
+	DirectionB string                 `yaml:"directionB,omitempty"`
+	VhfMin     string                 `yaml:"vhfMin,omitempty"`
+	VhfMax     string                 `yaml:"vhfMax,omitempty"`
+	UhfMin     string                 `yaml:"uhfMin,omitempty"`
+	UhfMax     string                 `yaml:"uhfMax,omitempty"`
+	Vhf2Min    string                 `yaml:"vhf2Min,omitempty"`
+	Vhf2Max    string                 `yaml:"vhf2Max,omitempty"`
+	Uhf2Min    string                 `yaml:"uhf2Min,omitempty"`
+	Uhf2Max    string                 `yaml:"uhf2Max,omitempty"`
+	Offsets    []any                  `yaml:"offsets,omitempty"`
+	Additional map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+// AnytoneDmrSettings are the DMR settings of the Anytone extension
+type AnytoneDmrSettings struct {
+	GroupCallHangTime         string                 `yaml:"groupCallHangTime,omitempty"`
+	ManualGroupCallHangTime   string                 `yaml:"manualGroupCallHangTime,omitempty"`
+	PrivateCallHangTime       string                 `yaml:"privateCallHangTime,omitempty"`
+	ManualPrivateCallHangTime string                 `yaml:"manualPrivateCallHangTime,omitempty"`
+	PreWaveDelay              *int                   `yaml:"preWaveDelay,omitempty"`
+	WakeHeadPeriod            *int                   `yaml:"wakeHeadPeriod,omitempty"`
+	FilterOwnID               *bool                  `yaml:"filterOwnID,omitempty"`
+	MonitorSlotMatch          string                 `yaml:"monitorSlotMatch,omitempty"`
+	MonitorColorCodeMatch     *bool                  `yaml:"monitorColorCodeMatch,omitempty"`
+	MonitorIDMatch            *bool                  `yaml:"monitorIDMatch,omitempty"`
+	MonitorTimeSlotHold       *bool                  `yaml:"monitorTimeSlotHold,omitempty"`
+	SmsFormat                 string                 `yaml:"smsFormat,omitempty"`
+	SendTalkerAlias           *bool                  `yaml:"sendTalkerAlias,omitempty"`
+	TalkerAliasSource         string                 `yaml:"talkerAliasSource,omitempty"`
+	TalkerAliasEncoding       string                 `yaml:"talkerAliasEncoding,omitempty"`
+	Encryption                string                 `yaml:"encryption,omitempty"`
+	Additional                map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+// AnytoneGpsSettings are the GPS settings of the Anytone extension
+type AnytoneGpsSettings struct {
+	Units          string                 `yaml:"units,omitempty"`
+	TimeZone       string                 `yaml:"timeZone,omitempty"`
+	ReportPosition *bool                  `yaml:"reportPosition,omitempty"`
+	UpdatePeriod   string                 `yaml:"updatePeriod,omitempty"`
+	Mode           string                 `yaml:"mode,omitempty"`
+	Additional     map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+// AnytoneRoamingSettings are the roaming settings of the Anytone extension
+type AnytoneRoamingSettings struct {
+	AutoRoam          *bool                  `yaml:"autoRoam,omitempty"`
+	AutoRoamPeriod    string                 `yaml:"autoRoamPeriod,omitempty"`
+	AutoRoamDelay     *int                   `yaml:"autoRoamDelay,omitempty"`
+	RoamStart         string                 `yaml:"roamStart,omitempty"`
+	RoamReturn        string                 `yaml:"roamReturn,omitempty"`
+	RangeCheck        *bool                  `yaml:"rangeCheck,omitempty"`
+	CheckInterval     string                 `yaml:"checkInterval,omitempty"`
+	RetryCount        *int                   `yaml:"retryCount,omitempty"`
+	OutOfRangeAlert   string                 `yaml:"outOfRangeAlert,omitempty"`
+	Notification      *bool                  `yaml:"notification,omitempty"`
+	NotificationCount *int                   `yaml:"notificationCount,omitempty"`
+	GpsRoaming        *bool                  `yaml:"gpsRoaming,omitempty"`
+	Additional        map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+// AnytoneBluetoothSettings are the bluetooth settings of the Anytone extension
+type AnytoneBluetoothSettings struct {
+	PttLatch      *bool                  `yaml:"pttLatch,omitempty"`
+	PttSleepTimer *int                   `yaml:"pttSleepTimer,omitempty"`
+	Additional    map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
+
+// AnytoneSimplexRepeaterSettings are the simplex repeater settings of the Anytone extension
+type AnytoneSimplexRepeaterSettings struct {
+	Enabled    *bool                  `yaml:"enabled,omitempty"`
+	Monitor    *bool                  `yaml:"monitor,omitempty"`
+	TimeSlot   string                 `yaml:"timeSlot,omitempty"`
+	Additional map[string]interface{} `yaml:",inline"` // Any new keys will show up here to be roundtripped
+}
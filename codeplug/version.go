@@ -0,0 +1,147 @@
+package codeplug
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// The codeplug format changes between QDMR releases. Codeplugs in an older format are upgraded
+// to the one the model describes by applying the migrations from their version on.
+
+// CurrentVersion is the version of the codeplug format that the model describes
+const CurrentVersion = "0.12.0"
+
+var (
+	// ErrUnsupportedVersion is returned by Migrate for a codeplug in a format it can't upgrade
+	ErrUnsupportedVersion = errors.New("unsupported codeplug version")
+	// ErrNewerVersion is returned by Migrate for a codeplug in a newer format than
+	// CurrentVersion. The codeplug can still be used, since keys that aren't modelled are
+	// kept, but fields whose meaning changed may be misread.
+	ErrNewerVersion = errors.New("codeplug version is newer than supported")
+)
+
+// migrations upgrade a codeplug from one format to the next, in order
+var migrations = []struct {
+	from    version
+	migrate func(cp *Codeplug) error
+}{
+	{version{0, 11}, migrateRoaming},
+}
+
+// version is the major and minor version of a codeplug format. Patch releases don't change
+// the format.
+type version struct {
+	major, minor int
+}
+
+func parseVersion(s string) (version, error) {
+	parts := strings.Split(s, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return version{}, fmt.Errorf("%w %q", ErrUnsupportedVersion, s)
+	}
+	var v version
+	var err error
+	v.major, err = strconv.Atoi(parts[0])
+	if err == nil {
+		v.minor, err = strconv.Atoi(parts[1])
+	}
+	if err != nil {
+		return version{}, fmt.Errorf("%w %q", ErrUnsupportedVersion, s)
+	}
+	return v, nil
+}
+
+func (v version) less(o version) bool {
+	return v.major < o.major || (v.major == o.major && v.minor < o.minor)
+}
+
+// Migrate upgrades the codeplug to the CurrentVersion format and returns true if it was
+// changed. A codeplug without a version is assumed to be current. It returns an error wrapping
+// ErrUnsupportedVersion if the version is older than any format it can upgrade, and one
+// wrapping ErrNewerVersion if the codeplug is newer than CurrentVersion, which is left as is.
+func (cp *Codeplug) Migrate() (bool, error) {
+	if cp.Version == "" {
+		return false, nil
+	}
+	v, err := parseVersion(cp.Version)
+	if err != nil {
+		return false, err
+	}
+	current, _ := parseVersion(CurrentVersion)
+	switch {
+	case current.less(v):
+		return false, fmt.Errorf("%w: %s is newer than %s", ErrNewerVersion, cp.Version, CurrentVersion)
+	case v == current:
+		return false, nil
+	case v.less(migrations[0].from):
+		return false, fmt.Errorf("%w %s, the oldest format that can be upgraded is %d.%d",
+			ErrUnsupportedVersion, cp.Version, migrations[0].from.major, migrations[0].from.minor)
+	}
+	for _, m := range migrations {
+		if m.from.less(v) {
+			continue
+		}
+		err := m.migrate(cp)
+		if err != nil {
+			return false, fmt.Errorf("error upgrading codeplug from version %d.%d: %v", m.from.major, m.from.minor, err)
+		}
+	}
+	cp.Version = CurrentVersion
+	return true, nil
+}
+
+// migrateRoaming upgrades 0.11 roaming zones. In 0.11 the roaming section is a list of zones
+// of digital channels. 0.12 replaced it with roaming channels, which only have the
+// frequencies, color code and time slot, and roaming zones that list them.
+func migrateRoaming(cp *Codeplug) error {
+	section, ok := cp.Additional["roaming"]
+	if !ok {
+		return nil
+	}
+	var zones []*RoamingZone
+	err := recode(section, &zones)
+	if err != nil {
+		return fmt.Errorf("error reading roaming zones: %v", err)
+	}
+	delete(cp.Additional, "roaming")
+	// Roaming channel IDs by digital channel ID, so that a channel in several zones is only
+	// added once
+	roamingChannels := map[string]string{}
+	for _, z := range zones {
+		for i, id := range z.Channels {
+			rcID, ok := roamingChannels[id]
+			if !ok {
+				ch := cp.Channel(id)
+				if ch == nil || ch.Digital.ID == "" {
+					// Leave it for validation to report
+					continue
+				}
+				d := ch.Digital
+				rcID = cp.AddRoamingChannel(&RoamingChannel{
+					Name:        d.Name,
+					RxFrequency: d.RxFrequency,
+					TxFrequency: d.TxFrequency,
+					ColorCode:   DefaultableInt{Value: d.ColorCode, HasValue: true},
+					TimeSlot:    DefaultableString{Value: d.TimeSlot, HasValue: true},
+				}).ID
+				roamingChannels[id] = rcID
+			}
+			z.Channels[i] = rcID
+		}
+		cp.RoamingZones = append(cp.RoamingZones, z)
+	}
+	return nil
+}
+
+// recode decodes a value from the Additional map into a typed one
+func recode(in any, out any) error {
+	b, err := yaml.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return yaml.Unmarshal(b, out)
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Settings that are the same for every run, like API tokens, can be kept in a config file,
+// ~/.config/dmrfill/config.yaml on Linux. For example:
+//
+//	repeaterbook_token: 0123456789abcdef
+
+// Config holds the settings from the config file
+type Config struct {
+	RepeaterBookToken string `yaml:"repeaterbook_token"`
+}
+
+// repeaterBookTokenEnv is the environment variable for the RepeaterBook API token
+const repeaterBookTokenEnv = "REPEATERBOOK_TOKEN"
+
+// configFilePath returns the location of the config file
+func configFilePath() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, "dmrfill", "config.yaml"), nil
+}
+
+// LoadConfig reads the config file. It's not an error if there isn't one.
+func LoadConfig(fileName string) (*Config, error) {
+	var config Config
+	data, err := os.ReadFile(fileName)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &config, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	err = decoder.Decode(&config)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// resolveRepeaterBookToken sets the RepeaterBook API token from the environment or the
+// config file if it wasn't given with -rb_token
+func resolveRepeaterBookToken() error {
+	if repeaterBookToken != "" {
+		return nil
+	}
+	if token := os.Getenv(repeaterBookTokenEnv); token != "" {
+		repeaterBookToken = token
+		return nil
+	}
+	fileName, err := configFilePath()
+	if err != nil {
+		// No config directory, so no config file
+		return nil
+	}
+	config, err := LoadConfig(fileName)
+	if err != nil {
+		return fmt.Errorf("unable to load config file %s: %v", fileName, err)
+	}
+	repeaterBookToken = config.RepeaterBookToken
+	return nil
+}
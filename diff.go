@@ -0,0 +1,287 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jancona/dmrfill/codeplug"
+)
+
+// Compare two codeplugs and report what changed
+
+const (
+	changeAdded   = "added"
+	changeRemoved = "removed"
+	changeChanged = "changed"
+)
+
+type CodeplugDiff struct {
+	Zones      []Change `json:"zones"`
+	Channels   []Change `json:"channels"`
+	Contacts   []Change `json:"contacts"`
+	GroupLists []Change `json:"groupLists"`
+}
+
+type Change struct {
+	Change  string   `json:"change"` // added, removed or changed
+	ID      string   `json:"id"`
+	Name    string   `json:"name"`
+	Details []string `json:"details,omitempty"`
+}
+
+// DiffCodeplugs compares the before and after codeplugs, matching entities by ID.
+// Referenced IDs are resolved to names in the change details.
+func DiffCodeplugs(before, after *codeplug.Codeplug) CodeplugDiff {
+	return CodeplugDiff{
+		Zones: diffEntities(before.Zones, after.Zones, func(z *codeplug.Zone, cp *codeplug.Codeplug) map[string]string {
+			return map[string]string{
+				"name": z.Name,
+				"A":    strings.Join(namesOf(cp.Channels, z.A), ", "),
+				"B":    strings.Join(namesOf(cp.Channels, z.B), ", "),
+			}
+		}, before, after),
+		Channels: diffEntities(before.Channels, after.Channels, channelProperties, before, after),
+		Contacts: diffEntities(before.Contacts, after.Contacts, func(c *codeplug.Contact, cp *codeplug.Codeplug) map[string]string {
+			if c.DTMF.ID != "" {
+				return map[string]string{
+					"name":   c.DTMF.Name,
+					"number": strconv.Itoa(c.DTMF.Number),
+					"type":   "DTMF",
+				}
+			}
+			return map[string]string{
+				"name":   c.DMR.Name,
+				"number": strconv.Itoa(c.DMR.Number),
+				"type":   c.DMR.Type,
+			}
+		}, before, after),
+		GroupLists: diffEntities(before.GroupLists, after.GroupLists, func(g *codeplug.GroupList, cp *codeplug.Codeplug) map[string]string {
+			return map[string]string{
+				"name":     g.Name,
+				"contacts": strings.Join(namesOf(cp.Contacts, g.Contacts), ", "),
+			}
+		}, before, after),
+	}
+}
+
+func channelProperties(ch *codeplug.Channel, cp *codeplug.Codeplug) map[string]string {
+	if ch.Analog.ID != "" {
+		a := ch.Analog
+		return map[string]string{
+			"name":        a.Name,
+			"type":        "analog",
+			"rxFrequency": a.RxFrequency,
+			"txFrequency": a.TxFrequency,
+			"power":       defaultableStringValue(a.Power),
+			"bandwidth":   a.Bandwidth,
+			"rxTone":      toneString(a.RxTone),
+			"txTone":      toneString(a.TxTone),
+		}
+	}
+	d := ch.Digital
+	return map[string]string{
+		"name":        d.Name,
+		"type":        "digital",
+		"rxFrequency": d.RxFrequency,
+		"txFrequency": d.TxFrequency,
+		"power":       defaultableStringValue(d.Power),
+		"colorCode":   strconv.Itoa(d.ColorCode),
+		"timeSlot":    d.TimeSlot,
+		"groupList":   strings.Join(namesOf(cp.GroupLists, []string{d.GroupList}), ""),
+		"contact":     strings.Join(namesOf(cp.Contacts, []string{d.Contact}), ""),
+	}
+}
+
+func diffEntities[T codeplug.Named](before, after []T, properties func(T, *codeplug.Codeplug) map[string]string, beforeCP, afterCP *codeplug.Codeplug) []Change {
+	changes := []Change{}
+	afterIDs := map[string]struct{}{}
+	for _, a := range after {
+		afterIDs[a.GetID()] = struct{}{}
+	}
+	for _, b := range before {
+		if _, ok := afterIDs[b.GetID()]; !ok {
+			changes = append(changes, Change{
+				Change:  changeRemoved,
+				ID:      b.GetID(),
+				Name:    b.GetName(),
+				Details: propertyList(properties(b, beforeCP)),
+			})
+		}
+	}
+	for _, a := range after {
+		i := slices.IndexFunc(before, func(b T) bool { return b.GetID() == a.GetID() })
+		if i < 0 {
+			changes = append(changes, Change{
+				Change:  changeAdded,
+				ID:      a.GetID(),
+				Name:    a.GetName(),
+				Details: propertyList(properties(a, afterCP)),
+			})
+			continue
+		}
+		bp := properties(before[i], beforeCP)
+		ap := properties(a, afterCP)
+		var details []string
+		for _, k := range sortedKeys(ap) {
+			if bp[k] != ap[k] {
+				details = append(details, fmt.Sprintf("%s: %s -> %s", k, bp[k], ap[k]))
+			}
+		}
+		if len(details) > 0 {
+			changes = append(changes, Change{
+				Change:  changeChanged,
+				ID:      a.GetID(),
+				Name:    a.GetName(),
+				Details: details,
+			})
+		}
+	}
+	return changes
+}
+
+func propertyList(props map[string]string) []string {
+	var l []string
+	for _, k := range sortedKeys(props) {
+		if props[k] != "" {
+			l = append(l, k+": "+props[k])
+		}
+	}
+	return l
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// namesOf resolves IDs to entity names, falling back to the ID if it isn't found
+func namesOf[T codeplug.Named](items []T, ids []string) []string {
+	var names []string
+	for _, id := range ids {
+		if id == "" {
+			continue
+		}
+		name := id
+		for _, item := range items {
+			if item.GetID() == id {
+				name = item.GetName()
+				break
+			}
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+func defaultableStringValue(ds codeplug.DefaultableString) string {
+	if !ds.HasValue {
+		return "default"
+	}
+	return ds.Value
+}
+
+func toneString(t codeplug.Tone) string {
+	switch {
+	case t.CTCSS != 0:
+		return strconv.FormatFloat(t.CTCSS, 'f', 1, 64)
+	case t.DCS != 0:
+		return "D" + strconv.FormatFloat(t.DCS, 'f', -1, 64)
+	default:
+		return ""
+	}
+}
+
+func (d CodeplugDiff) sections() []struct {
+	title   string
+	changes []Change
+} {
+	return []struct {
+		title   string
+		changes []Change
+	}{
+		{"Zones", d.Zones},
+		{"Channels", d.Channels},
+		{"Contacts", d.Contacts},
+		{"Group Lists", d.GroupLists},
+	}
+}
+
+// WriteDiff writes the diff in the requested format, one of text, markdown or json
+func WriteDiff(w io.Writer, d CodeplugDiff, format string) error {
+	switch format {
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		encoder.SetEscapeHTML(false)
+		return encoder.Encode(d)
+	case "markdown":
+		return writeDiffMarkdown(w, d)
+	default:
+		return writeDiffText(w, d)
+	}
+}
+
+func writeDiffText(w io.Writer, d CodeplugDiff) error {
+	var b strings.Builder
+	for _, s := range d.sections() {
+		fmt.Fprintf(&b, "%s: %s\n", s.title, changeSummary(s.changes))
+		for _, c := range s.changes {
+			fmt.Fprintf(&b, "  %s %s %q\n", changeSymbol(c.Change), c.ID, c.Name)
+			for _, detail := range c.Details {
+				fmt.Fprintf(&b, "      %s\n", detail)
+			}
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeDiffMarkdown(w io.Writer, d CodeplugDiff) error {
+	var b strings.Builder
+	b.WriteString("# Codeplug Changes\n")
+	for _, s := range d.sections() {
+		fmt.Fprintf(&b, "\n## %s\n\n%s\n", s.title, changeSummary(s.changes))
+		if len(s.changes) == 0 {
+			continue
+		}
+		b.WriteString("\n| Change | ID | Name | Details |\n")
+		b.WriteString("| ------ | -- | ---- | ------- |\n")
+		for _, c := range s.changes {
+			fmt.Fprintf(&b, "| %s | %s | %s | %s |\n", c.Change, c.ID, markdownEscape(c.Name),
+				markdownEscape(strings.Join(c.Details, "<br>")))
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func changeSummary(changes []Change) string {
+	counts := map[string]int{}
+	for _, c := range changes {
+		counts[c.Change]++
+	}
+	return fmt.Sprintf("%d added, %d removed, %d changed", counts[changeAdded], counts[changeRemoved], counts[changeChanged])
+}
+
+func changeSymbol(change string) string {
+	switch change {
+	case changeAdded:
+		return "+"
+	case changeRemoved:
+		return "-"
+	default:
+		return "~"
+	}
+}
+
+func markdownEscape(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
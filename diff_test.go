@@ -0,0 +1,72 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jancona/dmrfill/codeplug"
+)
+
+func TestDiffCodeplugs(t *testing.T) {
+	parse := func(s string) *codeplug.Codeplug {
+		cp, err := codeplug.Parse([]byte(s))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cp
+	}
+	before := parse(`contacts:
+  - dmr: {id: cont1, name: Local, type: GroupCall, number: 9}
+  - dmr: {id: cont2, name: Parrot, type: PrivateCall, number: 9998}
+channels:
+  - analog: {id: ch1, name: 2m Call, rxFrequency: 146.520000 MHz, txFrequency: 146.520000 MHz, power: High}
+  - analog: {id: ch2, name: 70cm Call, rxFrequency: 446.000000 MHz, txFrequency: 446.000000 MHz}
+zones:
+  - {id: zone1, name: Calling, A: [ch1, ch2]}
+`)
+	after := parse(`contacts:
+  - dmr: {id: cont1, name: Local, type: GroupCall, number: 9}
+channels:
+  - analog: {id: ch1, name: 2m Simplex, rxFrequency: 146.520000 MHz, txFrequency: 146.520000 MHz, power: Low, rxTone: {ctcss: 100.0}}
+  - analog: {id: ch2, name: 70cm Call, rxFrequency: 446.000000 MHz, txFrequency: 446.000000 MHz}
+zones:
+  - {id: zone1, name: Calling | Simplex, A: [ch1, ch2]}
+`)
+	var b strings.Builder
+	err := WriteDiff(&b, DiffCodeplugs(before, after), "text")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `Zones: 0 added, 0 removed, 1 changed
+  ~ zone1 "Calling | Simplex"
+      A: 2m Call, 70cm Call -> 2m Simplex, 70cm Call
+      name: Calling -> Calling | Simplex
+Channels: 0 added, 0 removed, 1 changed
+  ~ ch1 "2m Simplex"
+      name: 2m Call -> 2m Simplex
+      power: High -> Low
+      rxTone:  -> 100.0
+Contacts: 0 added, 1 removed, 0 changed
+  - cont2 "Parrot"
+      name: Parrot
+      number: 9998
+      type: PrivateCall
+Group Lists: 0 added, 0 removed, 0 changed
+`
+	if b.String() != want {
+		t.Errorf("WriteDiff() text =\n%s\nwant:\n%s", b.String(), want)
+	}
+	b.Reset()
+	err = WriteDiff(&b, DiffCodeplugs(before, after), "markdown")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range []string{
+		`| changed | zone1 | Calling \| Simplex | A: 2m Call, 70cm Call -> 2m Simplex, 70cm Call<br>name: Calling -> Calling \| Simplex |`,
+		"| removed | cont2 | Parrot | name: Parrot<br>number: 9998<br>type: PrivateCall |",
+	} {
+		if !strings.Contains(b.String(), row) {
+			t.Errorf("WriteDiff() markdown doesn't contain %q:\n%s", row, b.String())
+		}
+	}
+}
@@ -1,21 +1,28 @@
 package main
 
 import (
-	"cmp"
+	"context"
 	"errors"
 	"flag"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"os"
-	"regexp"
+	"os/signal"
 	"slices"
 	"strconv"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/gregjones/httpcache"
 	"github.com/gregjones/httpcache/diskcache"
-	"gopkg.in/yaml.v3"
+	"github.com/jancona/dmrfill/api"
+	"github.com/jancona/dmrfill/codeplug"
+	"github.com/jancona/dmrfill/filter"
+	"github.com/jancona/dmrfill/radioid"
+	"github.com/jancona/dmrfill/repeaterbook"
 )
 
 const userAgent = "dmrfill/0.1 github.com/jancona/dmrfill n1adj@anconafamily.com"
@@ -32,52 +39,19 @@ func init() {
 	if err != nil {
 		fatal("error creating cache directory: %v", err)
 	}
-	cache := diskcache.New(cacheDir)
-	t := httpcache.NewTransport(cache)
+	t := httpcache.NewTransport(successCache{diskcache.New(cacheDir)})
 	t.MarkCachedResponses = true
 
-	cachingHttpClient = t.Client()
-}
-
-type filterFlags []filter
-
-// filter clauses look like 'name=val1[,val2]...'
-var filterRegex = regexp.MustCompile(`(\w+)=([^,]+(?:,[\w\s]+)*)`)
-var valuesRegex = regexp.MustCompile(`,?([^,]+)`)
-
-type filter struct {
-	key      string
-	rawValue string
-	value    []string
-}
-
-func (ff *filterFlags) String() string {
-	return fmt.Sprintf("%#v", *ff)
-}
-
-func (ff *filterFlags) Set(value string) error {
-	m := filterRegex.FindAllStringSubmatch(value, -1)
-	if len(m) == 0 {
-		return errors.New("invalid filter expression '" + value + "'")
-	}
-	f := filter{
-		key:      strings.ReplaceAll(m[0][1], "_", " "),
-		rawValue: m[0][2],
-	}
-	m2 := valuesRegex.FindAllStringSubmatch(f.rawValue, -1)
-	for _, v := range m2 {
-		f.value = append(f.value, v[1])
-	}
-	// logVeryVerbose("filter.Set(%s) %#v", value, f)
-	*ff = append(*ff, f)
-	return nil
+	cachingHttpClient = &http.Client{Transport: t, Timeout: api.DefaultTimeout}
 }
 
 var (
 	inFile             string
 	outFile            string
+	inPlace            bool
+	backup             bool
 	datasource         string
-	filters            filterFlags
+	filters            filter.Filters
 	zonePattern        string
 	glPattern          string
 	channelPattern     string
@@ -85,12 +59,28 @@ var (
 	talkgroupsRequired bool
 	dmrQuery           bool
 	naRepeaterBookDB   bool
+	repeaterBookToken  string
 	nameLength         int
+	shortenMode        string
+	abbrevFile         string
+	uniqueStrategy     string
+	asciiNames         bool
 	open               bool
 	onAir              bool
-	location           string
+	locations          locationFlags
 	radius             float64
 	radiusUnits        string
+	sortOrder          string
+	limit              int
+	diffFormat         string
+	diffFile           string
+	validate           bool
+	recipeFile         string
+	recipe             *Recipe
+	radioProfile       string
+	timeout            time.Duration
+	reportFile         string
+	logFormat          string
 	verbose            bool
 	veryVerbose        bool
 )
@@ -98,20 +88,37 @@ var (
 func init() {
 	flag.StringVar(&inFile, "in", "", "Input QDMR Codeplug YAML file (default STDIN)")
 	flag.StringVar(&outFile, "out", "", "Output QDMR Codeplug YAML file (default STDOUT)")
+	flag.BoolVar(&inPlace, "inplace", false, "Update the input file in place")
+	flag.BoolVar(&backup, "backup", false, "With -inplace, keep a copy of the input file with a timestamped .bak suffix")
 	flag.StringVar(&datasource, "ds", "", "Repeater data source, either RADIOID_DMR or REPEATERBOOK_FM (required)")
-	flag.Var(&filters, "f", "Filter clause of the form 'name=val1[,val2]...'")
+	flag.Var(&filters, "f", "Filter clause of the form 'name=val1[,val2]...', other operators are != ~ !~ > >= < <=")
 	flag.StringVar(&zonePattern, "zone", "$state_code $city:6 $callsign", "Pattern for forming DMR zone names, zone name for analog")
 	flag.StringVar(&glPattern, "gl", "", "Pattern for forming DMR group list names (default zone + ' $time_slot')")
 	flag.StringVar(&channelPattern, "ch", "$tg_name:8 $tg_number $time_slot $callsign $city", "Pattern for forming DMR channel names")
 	flag.StringVar(&power, "power", "High", "Channel power setting, one of ('Min' 'Low' 'Mid' 'High' 'Max')")
 	flag.BoolVar(&talkgroupsRequired, "tg", true, "Only include DMR repeaters that have talkgroups defined")
 	flag.BoolVar(&naRepeaterBookDB, "na", true, "Use North American RepeaterBook database. Set it to 'false' to query outside the US, Canada and Mexico.")
+	flag.StringVar(&repeaterBookToken, "rb_token", "", "RepeaterBook API token (default $"+repeaterBookTokenEnv+" or repeaterbook_token in the config file)")
 	flag.IntVar(&nameLength, "name_lim", 16, "Length limit for generated names")
+	flag.StringVar(&shortenMode, "shorten", shortenSmart, "How to shorten names to fit limits, one of ('smart' 'truncate')")
+	flag.StringVar(&abbrevFile, "abbrev", "", "YAML file of 'word: abbreviation' pairs to add to the built-in abbreviations")
+	flag.StringVar(&uniqueStrategy, "unique", uniqueNumber, "How to make duplicate generated names unique, one of ('number' 'frequency' 'callsign')")
+	flag.BoolVar(&asciiNames, "ascii", false, "Transliterate generated names to ASCII, e.g. München to Munchen")
 	flag.BoolVar(&open, "open", true, "Only include open repeaters")
 	flag.BoolVar(&onAir, "on_air", true, "Only include on-air repeaters")
-	flag.StringVar(&location, "loc", "", "Center location for proximity search, e.g. 'Bangor, ME', 'München'")
+	flag.Var(&locations, "loc", "Center location for proximity search, e.g. 'Bangor, ME', 'München'. Add ':radius' to override -radius. May be repeated to search several areas")
 	flag.Float64Var(&radius, "radius", 25, "Radius for proximity search")
 	flag.StringVar(&radiusUnits, "units", "miles", "Distance units for proximity search, one of ('miles' 'km')")
+	flag.StringVar(&sortOrder, "sort", sortName, "Order of repeaters and generated zones, one of ('name' 'distance' 'frequency' 'callsign' 'last_update')")
+	flag.IntVar(&limit, "limit", 0, "Maximum number of repeaters to add from each query, in -sort order (default no limit)")
+	flag.StringVar(&diffFormat, "diff", "", "Report changes between the input and output codeplugs, one of ('text' 'markdown' 'json')")
+	flag.StringVar(&diffFile, "diff_out", "", "Output file for the change report (default STDERR)")
+	flag.StringVar(&recipeFile, "recipe", "", "YAML recipe file listing the query steps to apply to the codeplug")
+	flag.BoolVar(&validate, "validate", false, "Validate the input codeplug and exit, no datasource is queried")
+	flag.StringVar(&radioProfile, "radio", "generic", "Radio profile for validation limits, one of ("+strings.Join(radioProfileNames(), " ")+")")
+	flag.DurationVar(&timeout, "timeout", 0, "Maximum time for the datasource queries, e.g. '2m' (default no limit)")
+	flag.StringVar(&reportFile, "report", "", "Write a JSON summary of the run to this file")
+	flag.StringVar(&logFormat, "log_format", logFormatText, "Log message format, one of ('text' 'json')")
 	flag.BoolVar(&verbose, "v", false, "verbose logging")
 	flag.BoolVar(&veryVerbose, "vv", false, "more verbose logging")
 }
@@ -122,48 +129,94 @@ const (
 )
 
 func main() {
-	var codeplug Codeplug
-	for i, a := range os.Args {
-		if i == 0 {
-			logVerbose("%s", a)
-		} else {
-			logVerbose("    %s", a)
-		}
-	}
-
-	yamlReader, yamlWriter := parseArguments()
-	defer func() {
-		yamlReader.Close()
-		yamlWriter.Close()
-	}()
+	yamlReader := parseArguments()
+	defer yamlReader.Close()
+	logger.Debug("starting", "args", strings.Join(os.Args, " "))
 
-	decoder := yaml.NewDecoder(yamlReader)
-	err := decoder.Decode(&codeplug)
+	input, err := io.ReadAll(yamlReader)
+	if err != nil {
+		fatal("Unable to read YAML input, file: %s: %v", inFile, err)
+	}
+	cp, err := codeplug.Parse(input)
 	if err != nil {
 		fatal("Unable to parse YAML input, file: %s: %v", inFile, err)
 	}
+	migrateCodeplug(cp)
+	if validate {
+		validateCodeplug(cp)
+		return
+	}
+	// Interrupting stops the queries, a second interrupt exits immediately
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	context.AfterFunc(ctx, stop)
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	inputIDs := codeplugIDs(cp)
+	if recipe != nil {
+		runRecipe(ctx, recipe, cp)
+	} else {
+		report.Queries = append(report.Queries, fillCodeplug(ctx, cp))
+	}
+	makeNamesUnique(cp, inputIDs)
+	arrangeZones(cp, inputIDs)
 	// pretty.Println(codeplug)
+	err = writeOutput(cp)
+	if err != nil {
+		fatal("Error writing YAML output, file: %s: %v", outFile, err)
+	}
+	if diffFormat != "" {
+		writeDiffReport(input, cp)
+	}
+	report.finish(cp, inputIDs)
+}
+
+// fatalQuery exits after a failed query, explaining if it was interrupted or timed out
+func fatalQuery(ctx context.Context, source string, err error) {
+	switch ctx.Err() {
+	case context.Canceled:
+		fatal("interrupted while querying %s", source)
+	case context.DeadlineExceeded:
+		fatal("timed out after %v querying %s, use -timeout to allow more time", timeout, source)
+	}
+	if errors.Is(err, repeaterbook.ErrTokenRequired) {
+		fatal("error querying %s: %v\nset the token with -rb_token, the %s environment variable or repeaterbook_token in the config file",
+			source, err, repeaterBookTokenEnv)
+	}
+	fatal("error querying %s: %v", source, err)
+}
+
+// fillCodeplug queries the datasource and adds the results to the codeplug
+func fillCodeplug(ctx context.Context, cp *codeplug.Codeplug) QueryReport {
+	q := QueryReport{Datasource: datasource, Filters: []string{}}
+	for _, f := range filters {
+		q.Filters = append(q.Filters, f.String())
+	}
 	switch datasource {
 	case radioID:
-		rbFilters := make(filterFlags, len(filters)+1)
-		copy(rbFilters, filters)
+		// Most filters are applied to the RepeaterBook query, but some fields only exist in RadioID
+		rbFilters, ridFilters := splitDMRFilters(filters)
 		rbFilters.Set("mode=dmr")
-		repeaterList, err := QueryRepeaterBook(rbFilters)
+		repeaterList, err := queryRepeaterBook(ctx, rbFilters)
 		if err != nil {
-			fatal("error querying RepeaterBook: %v", err)
+			fatalQuery(ctx, "RepeaterBook", err)
 		}
 		var b strings.Builder
 		b.WriteString("id=")
 		first := true
+		rbByDMRID := map[int]repeaterbook.Repeater{}
 		for _, r := range repeaterList.Results {
 			var id int
 			if r.DMRID == "" {
-				logVerbose("skipping repeater %s %s with empty DMRID", r.Callsign, r.Frequency)
+				skipRepeater(slog.LevelDebug, r.Callsign, r.Frequency, "empty DMRID")
 				continue
 			} else {
 				f, ok := r.DMRID.(float64)
 				if !ok || f == 0 {
-					logVerbose("skipping repeater %s %s with invalid DMRID: %v", r.Callsign, r.Frequency, r.DMRID)
+					skipRepeater(slog.LevelDebug, r.Callsign, r.Frequency, fmt.Sprintf("invalid DMRID %v", r.DMRID))
 					continue
 				}
 				id = int(f)
@@ -173,55 +226,63 @@ func main() {
 			}
 			b.WriteString(strconv.Itoa(id))
 			first = false
+			rbByDMRID[id] = r
+		}
+		if first {
+			logger.Info("no DMR repeaters found")
+			return q
 		}
-		ridFilters := make(filterFlags, len(filters)+1)
-		copy(rbFilters, filters)
 		ridFilters.Set(b.String())
 
-		result, err := QueryRadioID(ridFilters)
+		result, err := queryRadioID(ctx, ridFilters)
 		if err != nil {
-			fatal("error querying RadioID: %v", err)
+			fatalQuery(ctx, "RadioID", err)
+		}
+		for i := range result.Results {
+			repeater := &result.Results[i]
+			if rb, ok := rbByDMRID[repeater.ID]; ok {
+				repeater.County = rb.County
+				repeater.Landmark = rb.Landmark
+				if loc, ok := rb.GetLocation(); ok {
+					repeater.Location = &loc
+				}
+				repeater.Center = rb.Center
+			}
 		}
-		for _, repeater := range result.Results {
+		q.Found = len(result.Results)
+		sorted := sortRepeaters(result.Results, zonePattern)
+		q.Limited = q.Found - len(sorted)
+		for _, repeater := range sorted {
 			rxFreq, err := strconv.ParseFloat(repeater.Frequency, 64)
 			if err != nil {
-				logError("skipping repeater with bad Frequency %s: %v", repeater.Frequency, err)
+				skipRepeater(slog.LevelWarn, repeater.Callsign, repeater.Frequency, fmt.Sprintf("bad Frequency: %v", err))
 				continue
 			}
 			offset, err := strconv.ParseFloat(repeater.Offset, 64)
 			if err != nil {
-				logError("skipping repeater with bad Offset %s: %v", repeater.Offset, err)
+				skipRepeater(slog.LevelWarn, repeater.Callsign, repeater.Frequency, fmt.Sprintf("bad Offset %s: %v", repeater.Offset, err))
 				continue
 			}
 			txFreq := rxFreq + offset
 			zoneName := ReplaceArgs(zonePattern, repeater, nil)
-			// create a Zone
-			zone := Zone{
-				ID:   NewID(ToSliceOfIDer(codeplug.Zones), "zone"),
-				Name: zoneName,
-			}
-			// add it to the codeplug
-			codeplug.Zones = append(codeplug.Zones, &zone)
+			// create a Zone and add it to the codeplug
+			zone := cp.AddZone(&codeplug.Zone{Name: zoneName})
+			nameSources[zone.ID] = nameSource{repeater, nameLength}
 			// create two group lists, one for each timeslot
-			tg := TalkGroup{
+			tg := radioid.TalkGroup{
 				TimeSlot: 1,
 			}
-			gl1 := GroupList{
-				ID:   NewID(ToSliceOfIDer(codeplug.GroupLists), "grp"),
-				Name: ReplaceArgs(glPattern, repeater, &tg),
-			}
-			codeplug.GroupLists = append(codeplug.GroupLists, &gl1)
+			gl1 := cp.AddGroupList(&codeplug.GroupList{Name: ReplaceArgs(glPattern, repeater, &tg)})
+			nameSources[gl1.ID] = nameSource{repeater, nameLength}
 			tg.TimeSlot = 2
-			gl2 := GroupList{
-				ID:   NewID(ToSliceOfIDer(codeplug.GroupLists), "grp"),
-				Name: ReplaceArgs(glPattern, repeater, &tg),
-			}
-			codeplug.GroupLists = append(codeplug.GroupLists, &gl2)
+			gl2 := cp.AddGroupList(&codeplug.GroupList{Name: ReplaceArgs(glPattern, repeater, &tg)})
+			nameSources[gl2.ID] = nameSource{repeater, nameLength}
 
-			logVeryVerbose("repeater.TalkGroups: %#v", repeater.TalkGroups)
+			logger.Log(ctx, api.LevelTrace, "talkgroups", "callsign", repeater.Callsign, "talkgroups", fmt.Sprintf("%#v", repeater.TalkGroups))
 			for _, tg := range repeater.TalkGroups {
 				if tg.TimeSlot != 1 && tg.TimeSlot != 2 {
-					logError("skipping invalid timeslot: %#v", tg)
+					logger.Warn("skipping talkgroup with invalid time slot", "callsign", repeater.Callsign,
+						"talkgroup", tg.Number, "time_slot", tg.TimeSlot)
 					continue
 				}
 				// logVerbose("%#v", tg)
@@ -230,7 +291,7 @@ func main() {
 				//		 create it and add it to the proper group list
 				ts := "TS" + strconv.Itoa(tg.TimeSlot)
 				var glID string
-				c := GetOrCreateContact(&tg, &codeplug)
+				c := GetOrCreateContact(&tg, cp)
 				if tg.TimeSlot == 1 {
 					gl1.Contacts = append(gl1.Contacts, c.DMR.ID)
 					glID = gl1.ID
@@ -246,163 +307,212 @@ func main() {
 				//   create a channel for the combo
 				channelName := ReplaceArgs(channelPattern, repeater, &tg)
 
-				ch := Channel{
-					Digital: Digital{
-						ID:          NewID(ToSliceOfIDer(codeplug.Channels), "ch"),
+				ch := codeplug.Channel{
+					Digital: codeplug.Digital{
 						Name:        channelName,
 						RxFrequency: fmt.Sprintf("%f MHz", rxFreq),
 						TxFrequency: fmt.Sprintf("%f MHz", txFreq),
 						ColorCode:   repeater.ColorCode,
 						TimeSlot:    ts,
 						GroupList:   glID,
-						Power:       DefaultableString{Value: power, HasValue: true},
+						Power:       codeplug.DefaultableString{Value: power, HasValue: true},
 						Contact:     c.DMR.ID,
 						Admit:       "Always",
 					},
 				}
 				// add it to the codeplug
-				codeplug.Channels = append(codeplug.Channels, &ch)
+				cp.AddChannel(&ch)
+				nameSources[ch.Digital.ID] = nameSource{repeater, nameLength}
 				// and to the zone
 				zone.A = append(zone.A, ch.Digital.ID)
 			}
+			q.Added++
 		}
 
 	case repeaterBook:
 		filters.Set("mode=analog")
-		result, err := QueryRepeaterBook(filters)
+		result, err := queryRepeaterBook(ctx, filters)
 		if err != nil {
-			fatal("error querying RepeaterBook: %v", err)
+			fatalQuery(ctx, "RepeaterBook", err)
 		}
-		// create a Zone for the repeaters queried
-		zone := Zone{
-			ID:   NewID(ToSliceOfIDer(codeplug.Zones), "zone"),
-			Name: zonePattern,
+		// The zone pattern is usually a fixed name for all the repeaters queried, but it can
+		// use variables like $center to put them in several zones
+		zones := map[string]*codeplug.Zone{}
+		zonePerRepeater := patternHasVars(zonePattern)
+		if !zonePerRepeater {
+			// add it to the codeplug
+			zone := cp.AddZone(&codeplug.Zone{Name: patternText(zonePattern)})
+			zones[zone.Name] = zone
 		}
-		// add it to the codeplug
-		codeplug.Zones = append(codeplug.Zones, &zone)
-		for _, repeater := range result.Results {
+		q.Found = len(result.Results)
+		sorted := sortRepeaters(result.Results, channelPattern)
+		q.Limited = q.Found - len(sorted)
+		for _, repeater := range sorted {
 			rxFreq, err := strconv.ParseFloat(repeater.Frequency, 64)
 			if err != nil {
-				logError("skipping repeater with bad Frequency %s: %v", repeater.Frequency, err)
+				skipRepeater(slog.LevelWarn, repeater.Callsign, repeater.Frequency, fmt.Sprintf("bad Frequency: %v", err))
 				continue
 			}
 			txFreq, err := strconv.ParseFloat(repeater.InputFreq, 64)
 			if err != nil {
-				logError("skipping repeater with bad InputFreq %s: %v", repeater.InputFreq, err)
+				skipRepeater(slog.LevelWarn, repeater.Callsign, repeater.Frequency, fmt.Sprintf("bad InputFreq %s: %v", repeater.InputFreq, err))
 				continue
 			}
-			var rxTone, txTone Tone
+			var rxTone, txTone codeplug.Tone
 			if repeater.TSQ != "" {
 				err = rxTone.Set(repeater.TSQ)
 				if err != nil {
-					logError("skipping repeater with bad TSQ %s: %v", repeater.TSQ, err)
+					skipRepeater(slog.LevelWarn, repeater.Callsign, repeater.Frequency, fmt.Sprintf("bad TSQ %s: %v", repeater.TSQ, err))
 					continue
 				}
 			}
 			if repeater.PL != "" {
 				err = txTone.Set(repeater.PL)
 				if err != nil {
-					logError("skipping repeater with bad PL %s: %v", repeater.PL, err)
+					skipRepeater(slog.LevelWarn, repeater.Callsign, repeater.Frequency, fmt.Sprintf("bad PL %s: %v", repeater.PL, err))
 					continue
 				}
 			}
 			//   create a channel
 			channelName := ReplaceArgs(channelPattern, repeater, nil)
 
-			ch := Channel{
-				Analog: Analog{
-					ID:          NewID(ToSliceOfIDer(codeplug.Channels), "ch"),
+			ch := codeplug.Channel{
+				Analog: codeplug.Analog{
 					Name:        channelName,
 					RxFrequency: fmt.Sprintf("%f MHz", rxFreq),
 					TxFrequency: fmt.Sprintf("%f MHz", txFreq),
 					Admit:       "Always",
 					Bandwidth:   "Wide",
-					Power:       DefaultableString{Value: power, HasValue: true},
+					Power:       codeplug.DefaultableString{Value: power, HasValue: true},
 					RxTone:      rxTone,
 					TxTone:      txTone,
 				},
 			}
 			// add it to the codeplug
-			codeplug.Channels = append(codeplug.Channels, &ch)
+			cp.AddChannel(&ch)
+			nameSources[ch.Analog.ID] = nameSource{repeater, nameLength}
 			// and to the zone
+			zoneName := patternText(zonePattern)
+			if zonePerRepeater {
+				zoneName = ReplaceArgs(zonePattern, repeater, nil)
+			}
+			zone, ok := zones[zoneName]
+			if !ok {
+				zone = cp.AddZone(&codeplug.Zone{Name: zoneName})
+				nameSources[zone.ID] = nameSource{nameLength: nameLength}
+				zones[zoneName] = zone
+			}
 			zone.A = append(zone.A, ch.Analog.ID)
+			q.Added++
 		}
 	}
-	for _, z := range codeplug.Zones {
-		slices.SortStableFunc(z.A, func(a, b string) int {
-			return cmp.Compare(getChannelName(a, codeplug), getChannelName(b, codeplug))
-		})
+	return q
+}
 
-	}
-	slices.SortStableFunc(codeplug.Zones, func(a, b *Zone) int {
-		return cmp.Compare(a.Name, b.Name)
-	})
-	// pretty.Println(codeplug)
-	encoder := yaml.NewEncoder(yamlWriter)
-	encoder.SetIndent(2)
-	err = encoder.Encode(codeplug)
-	if err != nil {
-		fatal("Error encoding YAML output, file: %s: %v", outFile, err)
+// migrateCodeplug upgrades a codeplug in an older format to the current one
+func migrateCodeplug(cp *codeplug.Codeplug) {
+	from := cp.Version
+	migrated, err := cp.Migrate()
+	switch {
+	case errors.Is(err, codeplug.ErrNewerVersion):
+		logger.Warn(fmt.Sprintf("%v, some settings may not be understood", err))
+	case err != nil:
+		fatal("Unable to use YAML input, file: %s: %v", inFile, err)
+	case migrated:
+		logger.Info("upgraded codeplug", "from", from, "to", codeplug.CurrentVersion)
 	}
 }
-func getChannelName(id string, codeplug Codeplug) string {
-	for _, ch := range codeplug.Channels {
-		if ch.Analog.ID == id {
-			return ch.Analog.Name
-		} else if ch.Digital.ID == id {
-			return ch.Digital.Name
+
+// validateCodeplug reports codeplug problems and exits with an error status if there are any errors
+func validateCodeplug(cp *codeplug.Codeplug) {
+	issues := ValidateCodeplug(cp, radioProfiles[radioProfile], nameLength)
+	report.Issues = issues
+	errorCount := 0
+	for _, issue := range issues {
+		level := slog.LevelWarn
+		if issue.Severity == severityError {
+			errorCount++
+			level = slog.LevelError
 		}
+		logger.Log(context.Background(), level, fmt.Sprintf("%s %s: %s", issue.Kind, issue.ID, issue.Message))
 	}
-	return ""
+	if errorCount > 0 {
+		fatal("%d errors found in codeplug %s", errorCount, inFile)
+	}
+	logger.Debug("codeplug is valid", "file", inFile)
+	report.finish(cp, codeplugIDs(cp))
 }
 
-func GetOrCreateContact(tg *TalkGroup, codeplug *Codeplug) *Contact {
-	for _, c := range codeplug.Contacts {
+// writeDiffReport compares the codeplug decoded from input with the final one. The input is
+// upgraded the same way first, so that the changes made by migrating it aren't reported.
+func writeDiffReport(input []byte, cp *codeplug.Codeplug) {
+	original, err := codeplug.Parse(input)
+	if err != nil {
+		fatal("Unable to parse YAML input, file: %s: %v", inFile, err)
+	}
+	// Migration errors were reported when the input was first upgraded
+	original.Migrate()
+	w := io.Writer(os.Stderr)
+	if diffFile != "" {
+		f, err := os.Create(diffFile)
+		if err != nil {
+			fatal("Unable to open diff output file %s: %v", diffFile, err)
+		}
+		defer f.Close()
+		w = f
+	}
+	err = WriteDiff(w, DiffCodeplugs(original, cp), diffFormat)
+	if err != nil {
+		fatal("Error writing diff report, file: %s: %v", diffFile, err)
+	}
+}
+func GetOrCreateContact(tg *radioid.TalkGroup, cp *codeplug.Codeplug) *codeplug.Contact {
+	for _, c := range cp.Contacts {
 		if c.DMR.ID != "" && c.DMR.Number == tg.Number {
 			return c
 		}
 	}
-	c := Contact{
-		DMR: DMR{
-			ID:     NewID(ToSliceOfIDer(codeplug.Contacts), "cont"),
-			Name:   tg.Name,
+	c := cp.AddContact(&codeplug.Contact{
+		DMR: codeplug.DMR{
+			Name:   uniqueContactName(cp, tg.Name),
 			Number: tg.Number,
 			Type:   "GroupCall",
 		},
+	})
+	if c.DMR.Name != tg.Name {
+		logger.Debug("renaming duplicate", "kind", "contact", "id", c.DMR.ID, "name", tg.Name, "new_name", c.DMR.Name)
 	}
-	codeplug.Contacts = append(codeplug.Contacts, &c)
-	return &c
+	return c
 }
 
-var idRegex = regexp.MustCompile(`([a-zA-Z]+)(\d+)`)
-
-func NewID(ids []IDer, defaultPrefix string) string {
-	if len(ids) == 0 {
-		return defaultPrefix + "1"
-	}
-	var prefix string
-	var lastNumber int
-	for _, id := range ids {
-		m := idRegex.FindAllStringSubmatch(id.GetID(), -1)
-		if len(m) > 0 {
-			prefix = m[0][1]
-			// Perhaps we should detect if prefix changes?
-			n, err := strconv.Atoi(m[0][2])
-			if err == nil && n > lastNumber {
-				lastNumber = n
-			}
+func parseArguments() io.ReadCloser {
+	flag.Parse()
+	err := newLogger()
+	if err != nil {
+		fatal("%v", err)
+	}
+	var yamlReader io.ReadCloser
 
+	if recipeFile != "" {
+		var err error
+		recipe, err = LoadRecipe(recipeFile)
+		if err != nil {
+			fatal("Unable to load recipe file %s: %v", recipeFile, err)
+		}
+		if inFile == "" {
+			inFile = recipe.In
+		}
+		if outFile == "" {
+			outFile = recipe.Out
 		}
 	}
-	return fmt.Sprint(prefix, lastNumber+1)
-}
 
-func parseArguments() (io.ReadCloser, io.WriteCloser) {
-	flag.Parse()
-	var (
-		yamlReader io.ReadCloser
-		yamlWriter io.WriteCloser
-	)
+	if !validate {
+		err := checkOutputFile()
+		if err != nil {
+			fatal("%v", err)
+		}
+	}
 
 	if inFile != "" {
 		yamlFile, err := os.Open(inFile)
@@ -414,28 +524,69 @@ func parseArguments() (io.ReadCloser, io.WriteCloser) {
 		yamlReader = os.Stdin
 	}
 
-	if outFile != "" {
-		yamlFile, err := os.Create(outFile)
+	if _, ok := radioProfiles[radioProfile]; !ok {
+		fatal("radio must be one of (%s)", strings.Join(radioProfileNames(), " "))
+	}
+
+	switch shortenMode {
+	case shortenSmart, shortenTruncate:
+		// good
+	default:
+		fatal("shorten must be one of (smart truncate)")
+	}
+
+	switch uniqueStrategy {
+	case uniqueNumber, uniqueFrequency, uniqueCallsign:
+		// good
+	default:
+		fatal("unique must be one of (number frequency callsign)")
+	}
+
+	err = resolveRepeaterBookToken()
+	if err != nil {
+		fatal("%v", err)
+	}
+
+	if abbrevFile != "" {
+		err := LoadAbbreviations(abbrevFile)
 		if err != nil {
-			fatal("Unable to open output file %s: %v", outFile, err)
+			fatal("Unable to load abbreviations file %s: %v", abbrevFile, err)
 		}
-		yamlWriter = yamlFile
-	} else {
-		yamlWriter = os.Stdout
 	}
 
+	switch diffFormat {
+	case "", "text", "markdown", "json":
+		// good
+	default:
+		fatal("diff must be one of (text markdown json)")
+	}
+	newClients()
+
+	if !validate && recipe == nil {
+		err := checkQueryOptions()
+		if err != nil {
+			fatal("%v", err)
+		}
+	}
+
+	return yamlReader
+}
+
+// checkQueryOptions checks the options that control a datasource query and fills in defaults
+// that depend on other options
+func checkQueryOptions() error {
 	switch datasource {
 	case radioID:
 		dmrQuery = true
 	case repeaterBook:
 		dmrQuery = false
 	default:
-		fatal("ds must be one of RADIOID_DMR or REPEATERBOOK_FM")
+		return errors.New("ds must be one of RADIOID_DMR or REPEATERBOOK_FM")
 	}
 
 	if !dmrQuery {
 		if zonePattern == flag.Lookup("zone").DefValue {
-			fatal("zone is required for analog datasources")
+			return errors.New("zone is required for analog datasources")
 		}
 		if channelPattern == flag.Lookup("ch").DefValue {
 			channelPattern = "$callsign $city"
@@ -446,60 +597,43 @@ func parseArguments() (io.ReadCloser, io.WriteCloser) {
 		glPattern = zonePattern + " $time_slot"
 	}
 
+	patterns := []string{channelPattern, zonePattern}
+	if dmrQuery {
+		patterns = append(patterns, glPattern)
+	}
+	for _, p := range patterns {
+		_, err := ParsePattern(p)
+		if err != nil {
+			return err
+		}
+	}
+
 	switch power {
 	case "Min", "Low", "Mid", "High", "Max":
 		// good
 	default:
-		fatal("power must be one of (Min Low Mid High Max)")
+		return errors.New("power must be one of (Min Low Mid High Max)")
 	}
 
 	if radius <= 0.0 {
-		fatal("radius must be greater than zero")
+		return errors.New("radius must be greater than zero")
 	}
 
 	switch radiusUnits {
 	case "miles", "km":
 		// good
 	default:
-		fatal("units must be one of (miles km)")
+		return errors.New("units must be one of (miles km)")
 	}
 
-	return yamlReader, yamlWriter
-}
-
-type IDer interface {
-	GetID() string
-}
-
-func ToSliceOfIDer[T IDer](s []T) []IDer {
-	result := make([]IDer, len(s))
-	for i, v := range s {
-		result[i] = v
+	if !slices.Contains(sortOrders, sortOrder) {
+		return errors.New("sort must be one of (" + strings.Join(sortOrders, " ") + ")")
 	}
-	return result
-}
-
-func fatal(f string, args ...any) {
-	fmt.Fprintf(os.Stderr, f+"\n", args...)
-	os.Exit(1)
-}
-
-func logError(f string, args ...any) {
-	fmt.Fprintf(os.Stderr, f+"\n", args...)
-}
-
-func logInfo(f string, args ...any) {
-	fmt.Fprintf(os.Stderr, f+"\n", args...)
-}
-
-func logVerbose(f string, args ...any) {
-	if verbose || veryVerbose {
-		fmt.Fprintf(os.Stderr, f+"\n", args...)
+	if sortOrder == sortDistance && len(locations) == 0 {
+		return errors.New("sort by distance requires a -loc proximity search")
 	}
-}
-
-func logVeryVerbose(f string, args ...any) {
-	if veryVerbose {
-		fmt.Fprintf(os.Stderr, f+"\n", args...)
+	if limit < 0 {
+		return errors.New("limit must not be negative")
 	}
+	return nil
 }
@@ -0,0 +1,280 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// End-to-end tests that run dmrfill against an httptest server replaying the datasource
+// responses recorded in testdata/fixtures, and compare the codeplugs and run reports with the
+// golden files in testdata/golden. After an intended change in the output, rewrite the golden
+// files with 'go test -update' and review the diff.
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata/golden")
+
+// runMainEnv is set in the environment of a test process that runs main instead of the tests,
+// to the URL of the fixture server
+const runMainEnv = "DMRFILL_TEST_SERVER"
+
+const testToken = "test-token"
+
+func TestMain(m *testing.M) {
+	if server := os.Getenv(runMainEnv); server != "" {
+		repeaterBookURL = server + "/repeaterbook"
+		repeaterBookROWURL = server + "/repeaterbook_row"
+		radioIDURL = server + "/radioid"
+		geoNamesURL = server + "/geonames"
+		main()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// fixtureServer replays the recorded responses in testdata/fixtures. A request for
+// /<api>?<query> is answered with testdata/fixtures/<api>/<query>.json, where the query is
+// unescaped with its parameters sorted, like 'mode=analog&state=Maine.json'. RepeaterBook
+// requests without the test token are rejected like the real API does.
+func fixtureServer(t *testing.T) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		api := strings.Trim(r.URL.Path, "/")
+		if strings.HasPrefix(api, "repeaterbook") && r.Header.Get("Authorization") != "Bearer "+testToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"status":"error","message":"Invalid or missing token"}`))
+			return
+		}
+		query, err := url.QueryUnescape(r.URL.Query().Encode())
+		if err != nil {
+			t.Errorf("bad query in request %s: %v", r.URL, err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		body, err := os.ReadFile(filepath.Join("testdata", "fixtures", api, query+".json"))
+		if err != nil {
+			t.Errorf("no fixture for request %s: %v", r.URL, err)
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// runDmrfill runs dmrfill with args in the testdata directory, using the fixture server and an
+// empty cache. It returns the output and the exit status.
+func runDmrfill(t *testing.T, srv *httptest.Server, args ...string) (stdout, stderr string, exitCode int) {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	t.Setenv(repeaterBookTokenEnv, testToken)
+	t.Setenv(runMainEnv, srv.URL)
+
+	exe, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command(exe, args...)
+	cmd.Dir = "testdata"
+	var out, errOut bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &errOut
+	err = cmd.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		exitCode = exitErr.ExitCode()
+	} else if err != nil {
+		t.Fatal(err)
+	}
+	return out.String(), errOut.String(), exitCode
+}
+
+// checkGolden compares got with the golden file, or rewrites the file with -update
+func checkGolden(t *testing.T, name, got string) {
+	t.Helper()
+	fileName := filepath.Join("testdata", "golden", name)
+	if *update {
+		err := os.WriteFile(fileName, []byte(got), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(fileName)
+	if err != nil {
+		t.Fatalf("%v, run 'go test -update' to create it", err)
+	}
+	if got != string(want) {
+		t.Errorf("output doesn't match %s, run 'go test -update' and review the diff if the change is intended\ngot:\n%s", fileName, got)
+	}
+}
+
+func TestGolden(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{"fm_state", []string{"-in", "base.yaml", "-ds", "REPEATERBOOK_FM", "-f", "state=Maine", "-zone", "ME Analog"}},
+		{"fm_proximity", []string{"-in", "base.yaml", "-ds", "REPEATERBOOK_FM", "-loc", "Bangor, ME", "-loc", "Portland, ME:10",
+			"-zone", "$center", "-ch", "$callsign $distance$bearing", "-sort", "distance"}},
+		{"fm_dollar_zone", []string{"-in", "base.yaml", "-ds", "REPEATERBOOK_FM", "-f", "state=Maine", "-zone", "ME $$ Analog"}},
+		{"fm_limit", []string{"-in", "base.yaml", "-ds", "REPEATERBOOK_FM", "-f", "state=Maine", "-f", "last_update>2022-01-01",
+			"-zone", "Recent", "-sort", "last_update", "-limit", "2"}},
+		{"fm_rest_of_world", []string{"-ds", "REPEATERBOOK_FM", "-na=false", "-f", "country=Germany", "-zone", "Bayern",
+			"-ch", "$callsign $city", "-ascii"}},
+		{"dmr_state", []string{"-in", "base.yaml", "-ds", "RADIOID_DMR", "-f", "state=Maine"}},
+		{"dmr_network", []string{"-in", "base.yaml", "-ds", "RADIOID_DMR", "-f", "state=Maine", "-f", "network=NEDECN",
+			"-zone", "$network $callsign", "-ch", "$tg_name $time_slot"}},
+		{"dmr_has_ts1", []string{"-in", "base.yaml", "-ds", "RADIOID_DMR", "-f", "state=Maine", "-f", "has=ts1"}},
+		{"dmr_contact_names", []string{"-in", "contact_names.yaml", "-ds", "RADIOID_DMR", "-f", "state=Maine", "-ch", "$callsign $tg_name"}},
+		{"dmr_empty_input", []string{"-ds", "RADIOID_DMR", "-f", "state=Maine"}},
+		{"recipe", []string{"-recipe", "recipe.yaml"}},
+		{"recipe_name_lim", []string{"-recipe", "recipe_name_lim.yaml"}},
+		{"migrate_0.11", []string{"-in", "base-0.11.yaml", "-ds", "RADIOID_DMR", "-f", "state=Maine"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := fixtureServer(t)
+			reportFile := filepath.Join(t.TempDir(), "report.json")
+			stdout, stderr, exitCode := runDmrfill(t, srv, append(tt.args, "-report", reportFile)...)
+			if exitCode != 0 {
+				t.Fatalf("exit status %d, stderr:\n%s", exitCode, stderr)
+			}
+			checkGolden(t, tt.name+".yaml", stdout)
+			report, err := os.ReadFile(reportFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkGolden(t, tt.name+".report.json", string(report))
+		})
+	}
+}
+
+// The change report compares the output with the input, after migrating it
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{"diff.txt", []string{"-in", "base.yaml", "-ds", "RADIOID_DMR", "-f", "state=Maine", "-diff", "text"}},
+		{"diff.md", []string{"-in", "base.yaml", "-ds", "RADIOID_DMR", "-f", "state=Maine", "-diff", "markdown"}},
+		{"diff.json", []string{"-in", "base.yaml", "-ds", "RADIOID_DMR", "-f", "state=Maine", "-diff", "json"}},
+		{"diff_migrate_0.11.txt", []string{"-in", "base-0.11.yaml", "-ds", "RADIOID_DMR", "-f", "state=Maine", "-diff", "text"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := fixtureServer(t)
+			diffFile := filepath.Join(t.TempDir(), tt.name)
+			_, stderr, exitCode := runDmrfill(t, srv, append(tt.args, "-diff_out", diffFile)...)
+			if exitCode != 0 {
+				t.Fatalf("exit status %d, stderr:\n%s", exitCode, stderr)
+			}
+			diff, err := os.ReadFile(diffFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkGolden(t, tt.name, string(diff))
+		})
+	}
+}
+
+func TestInPlace(t *testing.T) {
+	base, err := os.ReadFile(filepath.Join("testdata", "base.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fileName := filepath.Join(t.TempDir(), "codeplug.yaml")
+	err = os.WriteFile(fileName, base, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := fixtureServer(t)
+	args := []string{"-in", fileName, "-ds", "RADIOID_DMR", "-f", "state=Maine"}
+
+	_, stderr, exitCode := runDmrfill(t, srv, append(args, "-out", fileName)...)
+	if exitCode != 1 || !strings.Contains(stderr, "is the input file, use -inplace to update it") {
+		t.Errorf("exit status %d, want 1 and a refusal to overwrite the input, stderr:\n%s", exitCode, stderr)
+	}
+	_, stderr, exitCode = runDmrfill(t, srv, append(args, "-backup")...)
+	if exitCode != 1 || !strings.Contains(stderr, "backup can only be used with -inplace") {
+		t.Errorf("exit status %d, want 1 and a -backup error, stderr:\n%s", exitCode, stderr)
+	}
+	if b, _ := os.ReadFile(fileName); !bytes.Equal(b, base) {
+		t.Fatalf("the input file was changed after an error")
+	}
+
+	stdout, stderr, exitCode := runDmrfill(t, srv, append(args, "-inplace", "-backup")...)
+	if exitCode != 0 {
+		t.Fatalf("exit status %d, stderr:\n%s", exitCode, stderr)
+	}
+	if stdout != "" {
+		t.Errorf("-inplace wrote to stdout:\n%s", stdout)
+	}
+	out, err := os.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "dmr_state.yaml", string(out))
+	backups, err := filepath.Glob(fileName + ".*.bak")
+	if err != nil || len(backups) != 1 {
+		t.Fatalf("got backups %v, %v, want one", backups, err)
+	}
+	if b, _ := os.ReadFile(backups[0]); !bytes.Equal(b, base) {
+		t.Errorf("backup %s doesn't match the input", backups[0])
+	}
+}
+
+func TestValidate(t *testing.T) {
+	srv := fixtureServer(t)
+	_, stderr, exitCode := runDmrfill(t, srv, "-in", "invalid.yaml", "-validate")
+	if exitCode != 1 {
+		t.Errorf("exit status %d, want 1", exitCode)
+	}
+	checkGolden(t, "validate.txt", stderr)
+}
+
+func TestTokenRejected(t *testing.T) {
+	srv := fixtureServer(t)
+	_, stderr, exitCode := runDmrfill(t, srv, "-in", "base.yaml", "-ds", "REPEATERBOOK_FM", "-f", "state=Maine",
+		"-zone", "ME Analog", "-rb_token", "wrong")
+	if exitCode != 1 {
+		t.Errorf("exit status %d, want 1", exitCode)
+	}
+	if !strings.Contains(stderr, "rejected the API token") {
+		t.Errorf("stderr doesn't report the rejected token:\n%s", stderr)
+	}
+}
+
+// RepeaterBook reports some errors in the body of a 200 response
+func TestRepeaterBookErrorStatus(t *testing.T) {
+	tests := []struct {
+		state string
+		want  string
+	}{
+		{"Vermont", "RepeaterBook rejected the API token"},
+		{"New Hampshire", "RepeaterBook rate limit exceeded"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.state, func(t *testing.T) {
+			srv := fixtureServer(t)
+			_, stderr, exitCode := runDmrfill(t, srv, "-in", "base.yaml", "-ds", "REPEATERBOOK_FM", "-f", "state="+tt.state,
+				"-zone", "Analog")
+			if exitCode != 1 {
+				t.Errorf("exit status %d, want 1", exitCode)
+			}
+			if !strings.Contains(stderr, tt.want) {
+				t.Errorf("stderr doesn't contain %q:\n%s", tt.want, stderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+// Package filter parses and applies the filter expressions shared by the datasources.
+//
+// A filter clause looks like 'name<op>value'. Multiple clauses must all match (AND).
+// Within a clause, comma separated values are alternatives (OR), so 'band=2m,70cm' matches either
+// band and 'callsign!=W1ABC,W1XYZ' matches neither callsign. Operators are:
+//
+//	=   equal to any value, ignoring case
+//	!=  not equal to any value, ignoring case
+//	~   matches a regular expression, ignoring case
+//	!~  doesn't match a regular expression, ignoring case
+//	>, >=, <, <=  compares numbers, dates (2006-01-02) or, failing that, strings
+//
+// The 'has' field lists features of a repeater, like 'echolink' or 'ares', so 'has=echolink,allstar'
+// matches repeaters linked to either network.
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Filters is a list of filter clauses that must all match. It implements flag.Value, so it can
+// be used for a repeatable command line flag.
+type Filters []Filter
+
+var filterRegex = regexp.MustCompile(`^\s*(\w+)\s*(!=|!~|>=|<=|=|~|>|<)(.*)$`)
+
+// Filter is one filter clause
+type Filter struct {
+	Key      string   // The field name, with spaces in place of underscores
+	Op       string   // The operator
+	Values   []string // The alternative values
+	rawValue string
+	regex    *regexp.Regexp
+}
+
+func (f Filter) String() string {
+	return f.Key + f.Op + f.rawValue
+}
+
+// Parse parses a filter clause like 'state=Maine,New Hampshire'
+func Parse(value string) (Filter, error) {
+	m := filterRegex.FindStringSubmatch(value)
+	if m == nil || strings.TrimSpace(m[3]) == "" {
+		return Filter{}, errors.New("invalid filter expression '" + value + "'")
+	}
+	f := Filter{
+		Key:      strings.ReplaceAll(m[1], "_", " "),
+		Op:       m[2],
+		rawValue: m[3],
+	}
+	switch f.Op {
+	case "~", "!~":
+		// Regular expressions may contain commas, so they aren't split
+		re, err := regexp.Compile("(?i)" + f.rawValue)
+		if err != nil {
+			return Filter{}, fmt.Errorf("invalid regular expression in filter '%s': %v", value, err)
+		}
+		f.regex = re
+		f.Values = []string{f.rawValue}
+	case "=", "!=":
+		for _, v := range strings.Split(f.rawValue, ",") {
+			f.Values = append(f.Values, strings.TrimSpace(v))
+		}
+	default:
+		f.Values = []string{strings.TrimSpace(f.rawValue)}
+	}
+	return f, nil
+}
+
+func (ff *Filters) String() string {
+	return fmt.Sprintf("%v", *ff)
+}
+
+// Set parses a filter clause and adds it to the list
+func (ff *Filters) Set(value string) error {
+	f, err := Parse(value)
+	if err != nil {
+		return err
+	}
+	*ff = append(*ff, f)
+	return nil
+}
+
+// IsQuery returns true if the filter can be sent to the datasource as a query parameter
+func (f Filter) IsQuery() bool {
+	return f.Op == "="
+}
+
+// Matches checks the field of result, a struct, named by the filter key. fields maps filter
+// keys to struct field names, or to "" for fields computed by the result.
+func (f Filter) Matches(result any, fields map[string]string) bool {
+	rv := reflect.ValueOf(result)
+	vals := []string{FieldString(rv.FieldByName(fields[f.Key]))}
+	if cf, ok := result.(ComputedFielder); ok {
+		if cv, ok := cf.ComputedField(f.Key); ok {
+			vals = cv
+		}
+	}
+	// A field may have several values, e.g. 'has', so negations must match none of them
+	switch f.Op {
+	case "!=":
+		return !slices.ContainsFunc(vals, f.equalsAny)
+	case "!~":
+		return !slices.ContainsFunc(vals, f.regex.MatchString)
+	}
+	return slices.ContainsFunc(vals, f.matchesValue)
+}
+
+func (f Filter) matchesValue(val string) bool {
+	switch f.Op {
+	case "=":
+		return f.equalsAny(val)
+	case "~":
+		return f.regex.MatchString(val)
+	default:
+		c := compareValues(val, f.Values[0])
+		switch f.Op {
+		case ">":
+			return c > 0
+		case ">=":
+			return c >= 0
+		case "<":
+			return c < 0
+		case "<=":
+			return c <= 0
+		}
+	}
+	return false
+}
+
+// ComputedFielder is implemented by results with filter fields that are computed from other fields,
+// like 'has'
+type ComputedFielder interface {
+	ComputedField(key string) ([]string, bool)
+}
+
+// IsSet returns true if a result flag field like "Yes" or a node number is present
+func IsSet(val string) bool {
+	switch strings.ToLower(strings.TrimSpace(val)) {
+	case "", "0", "no":
+		return false
+	default:
+		return true
+	}
+}
+
+func (f Filter) equalsAny(val string) bool {
+	for _, fv := range f.Values {
+		if strings.EqualFold(fv, val) {
+			return true
+		}
+	}
+	return false
+}
+
+// FieldString converts a result field to a string for matching
+func FieldString(v reflect.Value) string {
+	if !v.IsValid() {
+		return ""
+	}
+	switch fv := v.Interface().(type) {
+	case string:
+		return fv
+	case time.Time:
+		if fv.IsZero() {
+			return ""
+		}
+		return fv.Format(time.DateTime)
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(fv)
+	}
+}
+
+var dateLayouts = []string{time.DateTime, time.DateOnly, time.RFC3339}
+
+// compareValues compares a and b as numbers if they are both numbers, as dates if they
+// are both dates or else as case insensitive strings
+func compareValues(a, b string) int {
+	af, aErr := strconv.ParseFloat(a, 64)
+	bf, bErr := strconv.ParseFloat(b, 64)
+	if aErr == nil && bErr == nil {
+		switch {
+		case af < bf:
+			return -1
+		case af > bf:
+			return 1
+		default:
+			return 0
+		}
+	}
+	at, aOK := ParseDate(a)
+	bt, bOK := ParseDate(b)
+	if aOK && bOK {
+		return at.Compare(bt)
+	}
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+// ParseDate parses a date in one of the formats used by the datasources and filters
+func ParseDate(s string) (time.Time, bool) {
+	for _, layout := range dateLayouts {
+		t, err := time.Parse(layout, s)
+		if err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
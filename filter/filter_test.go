@@ -0,0 +1,184 @@
+package filter
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+type result struct {
+	Callsign   string
+	Frequency  string
+	ColorCode  any
+	Precise    int
+	LastUpdate string
+	Updated    time.Time
+	Features   []string
+}
+
+func (r result) ComputedField(key string) ([]string, bool) {
+	if key == "has" {
+		return r.Features, true
+	}
+	return nil, false
+}
+
+var fields = map[string]string{
+	"callsign":    "Callsign",
+	"frequency":   "Frequency",
+	"color code":  "ColorCode",
+	"precise":     "Precise",
+	"last update": "LastUpdate",
+	"updated":     "Updated",
+	"has":         "",
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		value  string
+		key    string
+		op     string
+		values []string
+	}{
+		{"state=Maine", "state", "=", []string{"Maine"}},
+		{" state = Maine, New Hampshire ", "state", "=", []string{"Maine", "New Hampshire"}},
+		{"color_code!=1,2", "color code", "!=", []string{"1", "2"}},
+		{"callsign~^W1(A|B),x", "callsign", "~", []string{"^W1(A|B),x"}},
+		{"callsign!~^K", "callsign", "!~", []string{"^K"}},
+		{"frequency>144", "frequency", ">", []string{"144"}},
+		{"frequency>= 144.5", "frequency", ">=", []string{"144.5"}},
+		{"frequency<148", "frequency", "<", []string{"148"}},
+		{"frequency<=148", "frequency", "<=", []string{"148"}},
+	}
+	for _, tt := range tests {
+		f, err := Parse(tt.value)
+		if err != nil {
+			t.Errorf("Parse(%q) returned error %v", tt.value, err)
+			continue
+		}
+		if f.Key != tt.key || f.Op != tt.op || strings.Join(f.Values, "|") != strings.Join(tt.values, "|") {
+			t.Errorf("Parse(%q) = %q %q %q, want %q %q %q", tt.value, f.Key, f.Op, f.Values, tt.key, tt.op, tt.values)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"state", "invalid filter expression 'state'"},
+		{"state=", "invalid filter expression 'state='"},
+		{"state= ", "invalid filter expression 'state= '"},
+		{"=Maine", "invalid filter expression '=Maine'"},
+		{"callsign~W1(", "invalid regular expression in filter 'callsign~W1(': error parsing regexp: missing closing ): `(?i)W1(`"},
+		{"callsign!~[K", "invalid regular expression in filter 'callsign!~[K': error parsing regexp: missing closing ]: `[K`"},
+	}
+	for _, tt := range tests {
+		_, err := Parse(tt.value)
+		if err == nil {
+			t.Errorf("Parse(%q) didn't return an error", tt.value)
+		} else if err.Error() != tt.want {
+			t.Errorf("Parse(%q) error = %q, want %q", tt.value, err, tt.want)
+		}
+	}
+}
+
+func TestMatches(t *testing.T) {
+	r := result{
+		Callsign:   "W1IMD",
+		Frequency:  "145.180000",
+		ColorCode:  1.0,
+		Precise:    0,
+		LastUpdate: "2024-03-15",
+		Updated:    time.Date(2024, 3, 15, 12, 30, 0, 0, time.UTC),
+		Features:   []string{"echolink", "ares"},
+	}
+	tests := []struct {
+		filter string
+		want   bool
+	}{
+		// Equality, ignoring case, with alternatives
+		{"callsign=w1imd", true},
+		{"callsign=W1ABC,W1IMD", true},
+		{"callsign=W1ABC", false},
+		{"callsign!=W1ABC,W1XYZ", true},
+		{"callsign!=W1ABC,w1imd", false},
+		{"color_code=1", true},
+		{"precise=0", true},
+		// Regular expressions, ignoring case
+		{"callsign~^w1", true},
+		{"callsign~^K", false},
+		{"callsign!~^K", true},
+		{"callsign!~IMD$", false},
+		// Numbers compare numerically, not as strings
+		{"frequency>99", true},
+		{"frequency>145.18", false},
+		{"frequency>=145.18", true},
+		{"frequency<1000", true},
+		{"frequency<145.18", false},
+		{"frequency<=145.18", true},
+		{"color_code>=2", false},
+		// Dates, with or without a time
+		{"last_update>2024-01-01", true},
+		{"last_update>=2024-03-15", true},
+		{"last_update<2024-03-15", false},
+		{"last_update<=2024-03-15 00:00:00", true},
+		{"updated>2024-03-15", true},
+		{"updated<2024-03-15 13:00:00", true},
+		{"updated<2024-03-15T12:00:00Z", false},
+		// Strings when the values aren't both numbers or dates
+		{"callsign>W1", true},
+		{"callsign<=w1imd", true},
+		{"frequency<abc", true},
+		// Fields with several values
+		{"has=allstar,echolink", true},
+		{"has=allstar", false},
+		{"has!=allstar", true},
+		{"has!=ares", false},
+		{"has~^ECHO", true},
+		{"has!~link", false},
+		// Unknown fields are empty
+		{"town=Gray", false},
+		{"town!=Gray", true},
+		{"town~.", false},
+		{"town<A", true},
+	}
+	for _, tt := range tests {
+		f, err := Parse(tt.filter)
+		if err != nil {
+			t.Fatalf("Parse(%q) returned error %v", tt.filter, err)
+		}
+		got := f.Matches(r, fields)
+		if got != tt.want {
+			t.Errorf("%s Matches() = %v, want %v", tt.filter, got, tt.want)
+		}
+	}
+}
+
+func TestFiltersSet(t *testing.T) {
+	var ff Filters
+	for _, value := range []string{"state=Maine", "band=2m,70cm"} {
+		err := ff.Set(value)
+		if err != nil {
+			t.Fatalf("Set(%q) returned error %v", value, err)
+		}
+	}
+	if ff.String() != "[state=Maine band=2m,70cm]" {
+		t.Errorf("String() = %q, want %q", ff.String(), "[state=Maine band=2m,70cm]")
+	}
+	if err := ff.Set("state"); err == nil {
+		t.Errorf("Set(%q) didn't return an error", "state")
+	}
+	if len(ff) != 2 {
+		t.Errorf("got %d filters after an invalid one, want 2", len(ff))
+	}
+}
+
+func TestIsSet(t *testing.T) {
+	for val, want := range map[string]bool{"": false, "0": false, " No ": false, "Yes": true, "1234": true} {
+		if got := IsSet(val); got != want {
+			t.Errorf("IsSet(%q) = %v, want %v", val, got, want)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+//go:build ignore
+
+// gen_subdivisions generates iso3166_subdivisions.go, the ISO 3166-2 subdivision table, from
+// the iso_3166-2.json file of the Debian iso-codes project
+// (https://salsa.debian.org/iso-codes-team/iso-codes), which most Linux distributions install
+// in /usr/share/iso-codes/json. The ISO names of countries that don't use the Latin script are
+// romanized, so their names in the national language are added from the iso-codes translations
+// in /usr/share/locale. Run it with 'go generate' after updating iso-codes.
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	in      = flag.String("in", "/usr/share/iso-codes/json/iso_3166-2.json", "iso-codes ISO 3166-2 JSON file")
+	locales = flag.String("locales", "/usr/share/locale", "directory of the iso-codes translations")
+	out     = flag.String("out", "iso3166_subdivisions.go", "generated Go file")
+)
+
+// nationalLanguages are the locales of the translations added for countries whose names in
+// their national language aren't in the Latin script. iso-codes doesn't translate the Greek
+// and Korean names.
+var nationalLanguages = map[string]string{
+	"BG": "bg",
+	"BY": "be",
+	"CN": "zh_CN",
+	"GE": "ka",
+	"IL": "he",
+	"JP": "ja",
+	"RS": "sr",
+	"RU": "ru",
+	"TH": "th",
+	"TW": "zh_TW",
+	"UA": "uk",
+}
+
+type subdivision struct {
+	Code   string `json:"code"`
+	Name   string `json:"name"`
+	Parent string `json:"parent"`
+}
+
+// A name can be given to several subdivisions, like the community and the province of Madrid.
+// The one with the lowest rank is used: top level subdivisions before the ones within them,
+// names as given before the alternatives derived from them.
+type entry struct {
+	code string
+	rank int
+}
+
+// Alternative names in brackets may end with their own code, like
+// 'Isle of Anglesey [Sir Ynys Môn GB-YNM]'
+var bracketCode = regexp.MustCompile(`\s+[A-Z]{2}-[A-Z0-9]+$`)
+
+func main() {
+	flag.Parse()
+	b, err := os.ReadFile(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var data struct {
+		Subdivisions []subdivision `json:"3166-2"`
+	}
+	err = json.Unmarshal(b, &data)
+	if err != nil {
+		log.Fatalf("error reading %s: %v", *in, err)
+	}
+
+	translations := map[string]map[string]string{}
+	for country, locale := range nationalLanguages {
+		translations[country], err = readCatalog(filepath.Join(*locales, locale, "LC_MESSAGES", "iso_3166-2.mo"))
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	table := map[string]map[string]entry{}
+	for _, s := range data.Subdivisions {
+		country, code, ok := strings.Cut(s.Code, "-")
+		if !ok {
+			log.Fatalf("bad subdivision code %q", s.Code)
+		}
+		if table[country] == nil {
+			table[country] = map[string]entry{}
+		}
+		rank := 0
+		if s.Parent != "" {
+			rank = 2
+		}
+		subNames := names(s.Name)
+		if t := translations[country][s.Name]; t != "" {
+			subNames = append(subNames, names(t)...)
+		}
+		for i, name := range subNames {
+			e := entry{code: code, rank: rank}
+			if i > 0 {
+				e.rank++
+			}
+			if old, ok := table[country][name]; ok && old.rank <= e.rank {
+				continue
+			}
+			table[country][name] = e
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen_subdivisions.go from %s. DO NOT EDIT.\n\n", strings.TrimPrefix(*in, "/usr/share/"))
+	buf.WriteString("package main\n\n")
+	buf.WriteString("// isoSubdivisions maps ISO 3166-1 alpha-2 country codes to the names of their ISO 3166-2\n")
+	buf.WriteString("// subdivisions and their codes without the country prefix. Names that only differ in case or\n")
+	buf.WriteString("// accents match the first one listed.\n")
+	buf.WriteString("var isoSubdivisions = map[string][]subdivisionName{\n")
+	for _, country := range sortedKeys(table) {
+		fmt.Fprintf(&buf, "%q: {\n", country)
+		names := sortedKeys(table[country])
+		slices.SortStableFunc(names, func(a, b string) int {
+			return table[country][a].rank - table[country][b].rank
+		})
+		for _, name := range names {
+			fmt.Fprintf(&buf, "{%q, %q},\n", name, table[country][name].code)
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n")
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = os.WriteFile(*out, src, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// names returns a subdivision's name as given in iso-codes, followed by the alternatives in it.
+// 'Catalunya [Cataluña]' is also Cataluña, 'Madrid, Comunidad de' is also Comunidad de Madrid
+// and Madrid, 'Sofia (stolitsa)' is also Sofia and 'Bolama / Bijagós' is also Bolama and Bijagós.
+func names(name string) []string {
+	result := []string{}
+	add := func(n string) {
+		n = strings.TrimSpace(n)
+		if n != "" && !slices.Contains(result, n) {
+			result = append(result, n)
+		}
+	}
+	var alternatives []string
+	if i := strings.Index(name, " ["); i >= 0 && strings.HasSuffix(name, "]") {
+		alternatives = append(alternatives, bracketCode.ReplaceAllString(name[i+2:len(name)-1], ""))
+		name = name[:i]
+	}
+	add(name)
+	if i := strings.Index(name, " ("); i >= 0 && strings.HasSuffix(name, ")") {
+		add(name[:i])
+	}
+	if before, after, ok := strings.Cut(name, ", "); ok {
+		add(after + " " + before)
+		add(before)
+	}
+	if strings.Contains(name, " / ") {
+		for _, n := range strings.Split(name, " / ") {
+			add(n)
+		}
+	}
+	for _, n := range alternatives {
+		add(n)
+	}
+	return result
+}
+
+// readCatalog reads the translations in a gettext .mo file
+func readCatalog(fileName string) (map[string]string, error) {
+	b, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < 20 {
+		return nil, fmt.Errorf("%s is not a gettext catalog", fileName)
+	}
+	var order binary.ByteOrder = binary.LittleEndian
+	switch order.Uint32(b) {
+	case 0x950412de:
+	case 0xde120495:
+		order = binary.BigEndian
+	default:
+		return nil, fmt.Errorf("%s is not a gettext catalog", fileName)
+	}
+	count := int(order.Uint32(b[8:]))
+	originals, translated := int(order.Uint32(b[12:])), int(order.Uint32(b[16:]))
+	// str returns the string described by the length and offset at table entry i
+	str := func(table, i int) (string, error) {
+		entry := table + 8*i
+		if entry+8 > len(b) {
+			return "", fmt.Errorf("%s is truncated", fileName)
+		}
+		length, offset := int(order.Uint32(b[entry:])), int(order.Uint32(b[entry+4:]))
+		if offset+length > len(b) {
+			return "", fmt.Errorf("%s is truncated", fileName)
+		}
+		return string(b[offset : offset+length]), nil
+	}
+	catalog := map[string]string{}
+	for i := 0; i < count; i++ {
+		id, err := str(originals, i)
+		if err != nil {
+			return nil, err
+		}
+		text, err := str(translated, i)
+		if err != nil {
+			return nil, err
+		}
+		if id != "" && text != "" && text != id {
+			catalog[id] = text
+		}
+	}
+	return catalog, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
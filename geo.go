@@ -0,0 +1,78 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jancona/dmrfill/geo"
+	"gopkg.in/yaml.v3"
+)
+
+// searchLocation is the center of a proximity search, given as 'place[:radius]'
+type searchLocation struct {
+	Place  string
+	Radius float64 // in -units, 0 means use -radius
+}
+
+func (l searchLocation) String() string {
+	if l.Radius == 0 {
+		return l.Place
+	}
+	return l.Place + ":" + strconv.FormatFloat(l.Radius, 'f', -1, 64)
+}
+
+// radiusKm returns the search radius in km
+func (l searchLocation) radiusKm() float64 {
+	r := l.Radius
+	if r == 0 {
+		r = radius
+	}
+	if radiusUnits == "miles" {
+		r = r * geo.KmPerMile
+	}
+	return r
+}
+
+type locationFlags []searchLocation
+
+func (lf *locationFlags) String() string {
+	return fmt.Sprintf("%v", *lf)
+}
+
+func (lf *locationFlags) Set(value string) error {
+	l := searchLocation{Place: strings.TrimSpace(value)}
+	if i := strings.LastIndex(value, ":"); i >= 0 {
+		r, err := strconv.ParseFloat(strings.TrimSpace(value[i+1:]), 64)
+		if err == nil {
+			if r <= 0 {
+				return errors.New("radius must be greater than zero in location '" + value + "'")
+			}
+			l = searchLocation{Place: strings.TrimSpace(value[:i]), Radius: r}
+		}
+	}
+	if l.Place == "" {
+		return errors.New("invalid location '" + value + "'")
+	}
+	*lf = append(*lf, l)
+	return nil
+}
+
+// UnmarshalYAML accepts either a single location or a list, e.g. in a recipe
+func (lf *locationFlags) UnmarshalYAML(n *yaml.Node) error {
+	var values []string
+	if n.Kind == yaml.ScalarNode {
+		values = []string{n.Value}
+	} else if err := n.Decode(&values); err != nil {
+		return err
+	}
+	*lf = nil
+	for _, v := range values {
+		err := lf.Set(v)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+// Package geo has the distance and direction calculations for proximity searches
+package geo
+
+import "math"
+
+const earthRadiusKm = 6371.0
+
+// KmPerMile converts miles to kilometers
+const KmPerMile = 1.609344
+
+// Point is a location on the Earth
+type Point struct {
+	Lat float64
+	Lng float64
+}
+
+// Center is the geocoded center of a proximity search
+type Center struct {
+	Name string // The place that was searched for
+	Point
+}
+
+// DistanceKm returns the great circle distance between two points
+func DistanceKm(a, b Point) float64 {
+	lat1, lat2 := radians(a.Lat), radians(b.Lat)
+	dLat := lat2 - lat1
+	dLng := radians(b.Lng - a.Lng)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
+
+// Bearing returns the initial compass bearing in degrees from a to b
+func Bearing(a, b Point) float64 {
+	lat1, lat2 := radians(a.Lat), radians(b.Lat)
+	dLng := radians(b.Lng - a.Lng)
+	y := math.Sin(dLng) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLng)
+	return math.Mod(degrees(math.Atan2(y, x))+360, 360)
+}
+
+var compassPoints = []string{"N", "NE", "E", "SE", "S", "SW", "W", "NW"}
+
+// CompassPoint converts a bearing to one of the eight compass points
+func CompassPoint(deg float64) string {
+	return compassPoints[int(math.Round(deg/45))%8]
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func degrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
@@ -0,0 +1,148 @@
+// Package geonames is a client for the GeoNames search API, which dmrfill uses to find the
+// centers of proximity searches
+package geonames
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/jancona/dmrfill/api"
+	"github.com/jancona/dmrfill/geo"
+)
+
+// API Doc: https://www.geonames.org/export/ws-overview.html
+// Example: http://api.geonames.org/searchJSON?q=harfords%20point,%20me&maxRows=10&username=
+const DefaultBaseURL = "http://api.geonames.org/searchJSON"
+
+// ErrNotFound is returned by Geocode when no place matches
+var ErrNotFound = errors.New("no location found")
+
+// Options configures a Client
+type Options struct {
+	HTTPClient *http.Client // default api.DefaultClient
+	BaseURL    string       // default DefaultBaseURL
+	Username   string       // GeoNames account name (required)
+	UserAgent  string
+	Logger     *slog.Logger // default no logging
+}
+
+// Client searches GeoNames
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	username   string
+	userAgent  string
+	logger     *slog.Logger
+}
+
+// NewClient returns a client configured by opts
+func NewClient(opts Options) *Client {
+	c := &Client{
+		httpClient: opts.HTTPClient,
+		baseURL:    opts.BaseURL,
+		username:   opts.Username,
+		userAgent:  opts.UserAgent,
+		logger:     api.Logger(opts.Logger),
+	}
+	if c.httpClient == nil {
+		c.httpClient = api.DefaultClient
+	}
+	if c.baseURL == "" {
+		c.baseURL = DefaultBaseURL
+	}
+	return c
+}
+
+// Search returns the best match for the query, a place name like 'Bangor, ME'
+func (c *Client) Search(ctx context.Context, query string) (*Results, error) {
+	if c.username == "" {
+		return nil, errors.New("a GeoNames username is required")
+	}
+	baseURL, err := url.Parse(c.baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing base URL %s: %v", c.baseURL, err)
+	}
+	params := url.Values{}
+	params.Add("q", query)
+	params.Add("maxRows", "1")
+	params.Add("username", c.username)
+	baseURL.RawQuery = params.Encode()
+	c.logger.Debug("Geonames request", "url", baseURL.String())
+	req, err := api.NewRequest(ctx, baseURL.String(), c.userAgent)
+	if err != nil {
+		return nil, err
+	}
+	body, err := api.Fetch(c.httpClient, req, c.logger)
+	if err != nil {
+		return nil, err
+	}
+	result := Results{
+		Geonames: []Result{},
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing JSON response: %v", err)
+	}
+	// Errors like an exceeded limit are reported with a 200 status
+	if result.Status != nil {
+		return nil, fmt.Errorf("geonames returned an error: %s (code %d)", result.Status.Message, result.Status.Value)
+	}
+	c.logger.Debug("Geonames results", "count", result.TotalResultsCount)
+	return &result, nil
+}
+
+// Geocode returns the location of the place that best matches the query
+func (c *Client) Geocode(ctx context.Context, query string) (geo.Point, error) {
+	result, err := c.Search(ctx, query)
+	if err != nil {
+		return geo.Point{}, err
+	}
+	if result.TotalResultsCount < 1 || len(result.Geonames) == 0 {
+		return geo.Point{}, ErrNotFound
+	}
+	lat, err := strconv.ParseFloat(result.Geonames[0].Lat, 64)
+	if err != nil {
+		return geo.Point{}, fmt.Errorf("error parsing latitude %s: %v", result.Geonames[0].Lat, err)
+	}
+	lng, err := strconv.ParseFloat(result.Geonames[0].Lng, 64)
+	if err != nil {
+		return geo.Point{}, fmt.Errorf("error parsing longitude %s: %v", result.Geonames[0].Lng, err)
+	}
+	return geo.Point{Lat: lat, Lng: lng}, nil
+}
+
+type Results struct {
+	TotalResultsCount int
+	Geonames          []Result
+	Status            *Status `json:"status"`
+}
+
+// Status describes an error
+type Status struct {
+	Message string `json:"message"` // "the daily limit of 20000 credits for demo has been exceeded"
+	Value   int    `json:"value"`   // 18
+}
+
+type Result struct {
+	GeonameId   int    `json:"geonameId"`   // 4966529
+	Lat         string `json:"lat"`         // "45.49477"
+	Lng         string `json:"lng"`         // "-69.6145"
+	Name        string `json:"name"`        // "Harfords Point"
+	ToponymName string `json:"toponymName"` // "Harfords Point"
+	AdminName1  string `json:"adminName1"`  // "Maine"
+	AdminCode1  string `json:"adminCode1"`  // "ME"
+	CountryId   string `json:"countryId"`   // "6252001"
+	CountryCode string `json:"countryCode"` // "US"
+	CountryName string `json:"countryName"` // "United States"
+	Fcl         string `json:"fcl"`         // "T"
+	FclName     string `json:"fclName"`     // "mountain,hill,rock,... "
+	Population  int    `json:"population"`  // 0
+	FcodeName   string `json:"fcodeName"`   // "cape"
+	Fcode       string `json:"fcode"`       // "CAPE"
+}
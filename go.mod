@@ -4,6 +4,7 @@ go 1.22.3
 
 require (
 	github.com/gregjones/httpcache v0.0.0-20190611155906-901d90724c79
+	golang.org/x/text v0.21.0
 	gopkg.in/yaml.v3 v3.0.1
 )
 
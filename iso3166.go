@@ -0,0 +1,529 @@
+package main
+
+import "strings"
+
+// ISO 3166-1 country codes and ISO 3166-2 subdivision (state, province, region, etc.) codes,
+// used for $country_code and $state_code. Names are matched ignoring case and accents, so
+// 'Zurich', 'Zürich' and 'ZÜRICH' are all ZH. Subdivision codes are given without the
+// country prefix, e.g. BY for DE-BY Bayern. The ISO 3166-2 subdivisions are generated from
+// the iso-codes project's data by gen_subdivisions.go.
+
+//go:generate go run gen_subdivisions.go
+
+// isoCountries maps ISO 3166-1 alpha-2 codes to country names. The first name is the
+// ISO short name, the rest are common alternatives.
+var isoCountries = map[string][]string{
+	"AD": {"Andorra"},
+	"AE": {"United Arab Emirates", "UAE"},
+	"AF": {"Afghanistan"},
+	"AG": {"Antigua and Barbuda"},
+	"AI": {"Anguilla"},
+	"AL": {"Albania"},
+	"AM": {"Armenia"},
+	"AO": {"Angola"},
+	"AQ": {"Antarctica"},
+	"AR": {"Argentina"},
+	"AS": {"American Samoa"},
+	"AT": {"Austria"},
+	"AU": {"Australia"},
+	"AW": {"Aruba"},
+	"AX": {"Åland Islands"},
+	"AZ": {"Azerbaijan"},
+	"BA": {"Bosnia and Herzegovina"},
+	"BB": {"Barbados"},
+	"BD": {"Bangladesh"},
+	"BE": {"Belgium"},
+	"BF": {"Burkina Faso"},
+	"BG": {"Bulgaria"},
+	"BH": {"Bahrain"},
+	"BI": {"Burundi"},
+	"BJ": {"Benin"},
+	"BL": {"Saint Barthélemy"},
+	"BM": {"Bermuda"},
+	"BN": {"Brunei Darussalam", "Brunei"},
+	"BO": {"Bolivia"},
+	"BQ": {"Bonaire, Sint Eustatius and Saba", "Bonaire"},
+	"BR": {"Brazil"},
+	"BS": {"Bahamas"},
+	"BT": {"Bhutan"},
+	"BV": {"Bouvet Island"},
+	"BW": {"Botswana"},
+	"BY": {"Belarus"},
+	"BZ": {"Belize"},
+	"CA": {"Canada"},
+	"CC": {"Cocos (Keeling) Islands", "Cocos Islands"},
+	"CD": {"Democratic Republic of the Congo", "Congo, Democratic Republic of the"},
+	"CF": {"Central African Republic"},
+	"CG": {"Republic of the Congo", "Congo"},
+	"CH": {"Switzerland"},
+	"CI": {"Côte d'Ivoire", "Ivory Coast"},
+	"CK": {"Cook Islands"},
+	"CL": {"Chile"},
+	"CM": {"Cameroon"},
+	"CN": {"China"},
+	"CO": {"Colombia"},
+	"CR": {"Costa Rica"},
+	"CU": {"Cuba"},
+	"CV": {"Cabo Verde", "Cape Verde"},
+	"CW": {"Curaçao"},
+	"CX": {"Christmas Island"},
+	"CY": {"Cyprus"},
+	"CZ": {"Czechia", "Czech Republic"},
+	"DE": {"Germany"},
+	"DJ": {"Djibouti"},
+	"DK": {"Denmark"},
+	"DM": {"Dominica"},
+	"DO": {"Dominican Republic"},
+	"DZ": {"Algeria"},
+	"EC": {"Ecuador"},
+	"EE": {"Estonia"},
+	"EG": {"Egypt"},
+	"EH": {"Western Sahara"},
+	"ER": {"Eritrea"},
+	"ES": {"Spain"},
+	"ET": {"Ethiopia"},
+	"FI": {"Finland"},
+	"FJ": {"Fiji"},
+	"FK": {"Falkland Islands"},
+	"FM": {"Micronesia", "Federated States of Micronesia"},
+	"FO": {"Faroe Islands"},
+	"FR": {"France"},
+	"GA": {"Gabon"},
+	"GB": {"United Kingdom", "UK", "Great Britain", "England", "Scotland", "Wales", "Northern Ireland"},
+	"GD": {"Grenada"},
+	"GE": {"Georgia"},
+	"GF": {"French Guiana"},
+	"GG": {"Guernsey"},
+	"GH": {"Ghana"},
+	"GI": {"Gibraltar"},
+	"GL": {"Greenland"},
+	"GM": {"Gambia"},
+	"GN": {"Guinea"},
+	"GP": {"Guadeloupe"},
+	"GQ": {"Equatorial Guinea"},
+	"GR": {"Greece"},
+	"GS": {"South Georgia and the South Sandwich Islands"},
+	"GT": {"Guatemala"},
+	"GU": {"Guam"},
+	"GW": {"Guinea-Bissau"},
+	"GY": {"Guyana"},
+	"HK": {"Hong Kong"},
+	"HM": {"Heard Island and McDonald Islands"},
+	"HN": {"Honduras"},
+	"HR": {"Croatia"},
+	"HT": {"Haiti"},
+	"HU": {"Hungary"},
+	"ID": {"Indonesia"},
+	"IE": {"Ireland"},
+	"IL": {"Israel"},
+	"IM": {"Isle of Man"},
+	"IN": {"India"},
+	"IO": {"British Indian Ocean Territory"},
+	"IQ": {"Iraq"},
+	"IR": {"Iran"},
+	"IS": {"Iceland"},
+	"IT": {"Italy"},
+	"JE": {"Jersey"},
+	"JM": {"Jamaica"},
+	"JO": {"Jordan"},
+	"JP": {"Japan"},
+	"KE": {"Kenya"},
+	"KG": {"Kyrgyzstan"},
+	"KH": {"Cambodia"},
+	"KI": {"Kiribati"},
+	"KM": {"Comoros"},
+	"KN": {"Saint Kitts and Nevis"},
+	"KP": {"North Korea"},
+	"KR": {"South Korea", "Korea, Republic of", "Korea"},
+	"KW": {"Kuwait"},
+	"KY": {"Cayman Islands"},
+	"KZ": {"Kazakhstan"},
+	"LA": {"Laos"},
+	"LB": {"Lebanon"},
+	"LC": {"Saint Lucia"},
+	"LI": {"Liechtenstein"},
+	"LK": {"Sri Lanka"},
+	"LR": {"Liberia"},
+	"LS": {"Lesotho"},
+	"LT": {"Lithuania"},
+	"LU": {"Luxembourg"},
+	"LV": {"Latvia"},
+	"LY": {"Libya"},
+	"MA": {"Morocco"},
+	"MC": {"Monaco"},
+	"MD": {"Moldova"},
+	"ME": {"Montenegro"},
+	"MF": {"Saint Martin"},
+	"MG": {"Madagascar"},
+	"MH": {"Marshall Islands"},
+	"MK": {"North Macedonia", "Macedonia"},
+	"ML": {"Mali"},
+	"MM": {"Myanmar"},
+	"MN": {"Mongolia"},
+	"MO": {"Macao", "Macau"},
+	"MP": {"Northern Mariana Islands"},
+	"MQ": {"Martinique"},
+	"MR": {"Mauritania"},
+	"MS": {"Montserrat"},
+	"MT": {"Malta"},
+	"MU": {"Mauritius"},
+	"MV": {"Maldives"},
+	"MW": {"Malawi"},
+	"MX": {"Mexico"},
+	"MY": {"Malaysia"},
+	"MZ": {"Mozambique"},
+	"NA": {"Namibia"},
+	"NC": {"New Caledonia"},
+	"NE": {"Niger"},
+	"NF": {"Norfolk Island"},
+	"NG": {"Nigeria"},
+	"NI": {"Nicaragua"},
+	"NL": {"Netherlands", "The Netherlands", "Holland"},
+	"NO": {"Norway"},
+	"NP": {"Nepal"},
+	"NR": {"Nauru"},
+	"NU": {"Niue"},
+	"NZ": {"New Zealand"},
+	"OM": {"Oman"},
+	"PA": {"Panama"},
+	"PE": {"Peru"},
+	"PF": {"French Polynesia"},
+	"PG": {"Papua New Guinea"},
+	"PH": {"Philippines"},
+	"PK": {"Pakistan"},
+	"PL": {"Poland"},
+	"PM": {"Saint Pierre and Miquelon"},
+	"PN": {"Pitcairn"},
+	"PR": {"Puerto Rico"},
+	"PS": {"Palestine"},
+	"PT": {"Portugal"},
+	"PW": {"Palau"},
+	"PY": {"Paraguay"},
+	"QA": {"Qatar"},
+	"RE": {"Réunion"},
+	"RO": {"Romania"},
+	"RS": {"Serbia"},
+	"RU": {"Russia", "Russian Federation"},
+	"RW": {"Rwanda"},
+	"SA": {"Saudi Arabia"},
+	"SB": {"Solomon Islands"},
+	"SC": {"Seychelles"},
+	"SD": {"Sudan"},
+	"SE": {"Sweden"},
+	"SG": {"Singapore"},
+	"SH": {"Saint Helena"},
+	"SI": {"Slovenia"},
+	"SJ": {"Svalbard and Jan Mayen"},
+	"SK": {"Slovakia"},
+	"SL": {"Sierra Leone"},
+	"SM": {"San Marino"},
+	"SN": {"Senegal"},
+	"SO": {"Somalia"},
+	"SR": {"Suriname"},
+	"SS": {"South Sudan"},
+	"ST": {"Sao Tome and Principe"},
+	"SV": {"El Salvador"},
+	"SX": {"Sint Maarten"},
+	"SY": {"Syria"},
+	"SZ": {"Eswatini", "Swaziland"},
+	"TC": {"Turks and Caicos Islands"},
+	"TD": {"Chad"},
+	"TF": {"French Southern Territories"},
+	"TG": {"Togo"},
+	"TH": {"Thailand"},
+	"TJ": {"Tajikistan"},
+	"TK": {"Tokelau"},
+	"TL": {"Timor-Leste", "East Timor"},
+	"TM": {"Turkmenistan"},
+	"TN": {"Tunisia"},
+	"TO": {"Tonga"},
+	"TR": {"Türkiye", "Turkey"},
+	"TT": {"Trinidad and Tobago"},
+	"TV": {"Tuvalu"},
+	"TW": {"Taiwan"},
+	"TZ": {"Tanzania"},
+	"UA": {"Ukraine"},
+	"UG": {"Uganda"},
+	"UM": {"United States Minor Outlying Islands"},
+	"US": {"United States", "USA", "United States of America"},
+	"UY": {"Uruguay"},
+	"UZ": {"Uzbekistan"},
+	"VA": {"Holy See", "Vatican City"},
+	"VC": {"Saint Vincent and the Grenadines"},
+	"VE": {"Venezuela"},
+	"VG": {"British Virgin Islands", "Virgin Islands (British)"},
+	"VI": {"US Virgin Islands", "Virgin Islands (U.S.)"},
+	"VN": {"Viet Nam", "Vietnam"},
+	"VU": {"Vanuatu"},
+	"WF": {"Wallis and Futuna"},
+	"WS": {"Samoa"},
+	"YE": {"Yemen"},
+	"YT": {"Mayotte"},
+	"ZA": {"South Africa"},
+	"ZM": {"Zambia"},
+	"ZW": {"Zimbabwe"},
+}
+
+type subdivisionName struct {
+	name, code string
+}
+
+// subdivisions maps ISO 3166-1 alpha-2 country codes to subdivision names and their codes,
+// for English and other common names that aren't in isoSubdivisions, and the Greek names, which
+// iso-codes doesn't have
+var subdivisions = map[string]map[string]string{
+	"US": {
+		"Federated States of Micronesia": "FM",
+		"Marshall Islands":               "MH",
+		"Palau":                          "PW",
+	},
+	"CA": {
+		"Newfoundland": "NL",
+	},
+	"MX": {
+		"Mexico City":      "CMX",
+		"Distrito Federal": "CMX",
+		"Coahuila":         "COA",
+		"Estado de México": "MEX",
+		"Mexico State":     "MEX",
+		"Michoacán":        "MIC",
+		"Veracruz":         "VER",
+	},
+	"AR": {
+		"Capital Federal": "C",
+	},
+	"AT": {
+		"Carinthia":     "2",
+		"Lower Austria": "3",
+		"Upper Austria": "4",
+		"Styria":        "6",
+		"Tyrol":         "7",
+		"Vienna":        "9",
+	},
+	"BE": {
+		"Brussels":         "BRU",
+		"Bruxelles":        "BRU",
+		"Brussel":          "BRU",
+		"Brussels-Capital": "BRU",
+		"Vlaanderen":       "VLG",
+		"Flanders":         "VLG",
+		"Wallonie":         "WAL",
+		"Wallonia":         "WAL",
+		"Antwerp":          "VAN",
+		"Walloon Brabant":  "WBR",
+		"East Flanders":    "VOV",
+		"Flemish Brabant":  "VBR",
+		"West Flanders":    "VWV",
+	},
+	"CH": {
+		"Berne":      "BE",
+		"Fribourg":   "FR",
+		"Geneva":     "GE",
+		"Grisons":    "GR",
+		"Lucerne":    "LU",
+		"St. Gallen": "SG",
+		"St Gallen":  "SG",
+		"Wallis":     "VS",
+	},
+	"DE": {
+		"Bavaria":                       "BY",
+		"Hesse":                         "HE",
+		"Mecklenburg-Western Pomerania": "MV",
+		"Lower Saxony":                  "NI",
+		"North Rhine-Westphalia":        "NW",
+		"Rhineland-Palatinate":          "RP",
+		"Saxony":                        "SN",
+		"Saxony-Anhalt":                 "ST",
+		"Thuringia":                     "TH",
+	},
+	"DK": {
+		"Capital Region of Denmark":  "84",
+		"Capital Region":             "84",
+		"Central Denmark Region":     "82",
+		"Central Jutland":            "82",
+		"North Denmark Region":       "81",
+		"North Jutland":              "81",
+		"Region Zealand":             "85",
+		"Zealand":                    "85",
+		"Region of Southern Denmark": "83",
+		"Southern Denmark":           "83",
+	},
+	"ES": {
+		"Andalusia":            "AN",
+		"Balearic Islands":     "IB",
+		"Canary Islands":       "CN",
+		"Catalonia":            "CT",
+		"Navarra":              "NC",
+		"Navarre":              "NC",
+		"País Vasco":           "PV",
+		"Euskadi":              "PV",
+		"Basque Country":       "PV",
+		"Comunitat Valenciana": "VC",
+		"Valencia":             "VC",
+	},
+	"FR": {
+		"Brittany":                   "BRE",
+		"Corsica":                    "20R",
+		"Grand Est":                  "GES",
+		"Normandy":                   "NOR",
+		"Pays de la Loire":           "PDL",
+		"Provence-Alpes-Côte d'Azur": "PAC",
+	},
+	"GR": {
+		"Άγιον Όρος":  "69",
+		"Mount Athos": "69",
+		"Ανατολική Μακεδονία και Θράκη": "A",
+		"Κεντρική Μακεδονία":            "B",
+		"Δυτική Μακεδονία":              "C",
+		"Ήπειρος":                       "D",
+		"Epirus":                        "D",
+		"Θεσσαλία":                      "E",
+		"Thessaly":                      "E",
+		"Ιόνια Νησιά":                   "F",
+		"Ionian Islands":                "F",
+		"Δυτική Ελλάδα":                 "G",
+		"Western Greece":                "G",
+		"Στερεά Ελλάδα":                 "H",
+		"Central Greece":                "H",
+		"Αττική":                        "I",
+		"Attica":                        "I",
+		"Πελοπόννησος":                  "J",
+		"Peloponnese":                   "J",
+		"Βόρειο Αιγαίο":                 "K",
+		"North Aegean":                  "K",
+		"Νότιο Αιγαίο":                  "L",
+		"South Aegean":                  "L",
+		"Κρήτη":                         "M",
+		"Crete":                         "M",
+	},
+	"IE": {
+		"Connacht": "C",
+	},
+	"IT": {
+		"Friuli-Venezia Giulia": "36",
+		"Lombardy":              "25",
+		"Piedmont":              "21",
+		"Apulia":                "75",
+		"Sardinia":              "88",
+		"Sicily":                "82",
+		"Tuscany":               "52",
+		"Trentino-Südtirol":     "32",
+		"Valle d'Aosta":         "23",
+		"Aosta Valley":          "23",
+	},
+	"NL": {
+		"Friesland":     "FR",
+		"North Brabant": "NB",
+		"North Holland": "NH",
+		"South Holland": "ZH",
+	},
+	"NZ": {
+		"Chatham Islands":    "CIT",
+		"Manawatū-Whanganui": "MWT",
+	},
+	"PT": {
+		"Lisbon":  "11",
+		"Açores":  "20",
+		"Azores":  "20",
+		"Madeira": "30",
+	},
+	"SE": {
+		"Stockholm":       "AB",
+		"Västerbotten":    "AC",
+		"Norrbotten":      "BD",
+		"Uppsala":         "C",
+		"Södermanland":    "D",
+		"Östergötland":    "E",
+		"Jönköping":       "F",
+		"Kronoberg":       "G",
+		"Kalmar":          "H",
+		"Gotland":         "I",
+		"Blekinge":        "K",
+		"Skåne":           "M",
+		"Scania":          "M",
+		"Halland":         "N",
+		"Västra Götaland": "O",
+		"Värmland":        "S",
+		"Örebro":          "T",
+		"Västmanland":     "U",
+		"Dalarna":         "W",
+		"Gävleborg":       "X",
+		"Västernorrland":  "Y",
+		"Jämtland":        "Z",
+	},
+	"ZA": {
+		"North West": "NW",
+	},
+}
+
+var (
+	countryCodes     = map[string]string{}            // normalized name -> country code
+	subdivisionCodes = map[string]map[string]string{} // country code -> normalized name -> subdivision code
+)
+
+func init() {
+	for code, names := range isoCountries {
+		for _, name := range names {
+			countryCodes[normalizePlaceName(name)] = code
+		}
+	}
+	for country, subs := range isoSubdivisions {
+		codes := map[string]string{}
+		for _, s := range subs {
+			name := normalizePlaceName(s.name)
+			if _, ok := codes[name]; !ok {
+				codes[name] = s.code
+			}
+		}
+		subdivisionCodes[country] = codes
+	}
+	for country, subs := range subdivisions {
+		if subdivisionCodes[country] == nil {
+			subdivisionCodes[country] = map[string]string{}
+		}
+		for name, code := range subs {
+			subdivisionCodes[country][normalizePlaceName(name)] = code
+		}
+	}
+}
+
+// normalizePlaceName lower cases name and removes accents so names can be matched loosely.
+// Letters of other scripts than Latin are kept, so that names in Greek or Cyrillic match.
+func normalizePlaceName(name string) string {
+	return strings.ToLower(squeeze(foldAccents(name)))
+}
+
+// countryCode returns the ISO 3166-1 alpha-2 code for a country name or code, or "" if it's unknown
+func countryCode(country string) string {
+	if _, ok := isoCountries[strings.ToUpper(country)]; ok {
+		return strings.ToUpper(country)
+	}
+	return countryCodes[normalizePlaceName(country)]
+}
+
+// subdivisionCode returns the ISO 3166-2 subdivision code, without the country prefix, for a
+// state or region in a country. If the country is unknown, US states and Canadian provinces are
+// searched. It returns "" if the subdivision is unknown.
+func subdivisionCode(state, country string) string {
+	if state == "" {
+		return ""
+	}
+	cc := countryCode(country)
+	searched := []string{cc}
+	if cc == "" {
+		searched = []string{"US", "CA"}
+	}
+	name := normalizePlaceName(state)
+	for _, c := range searched {
+		if code, ok := subdivisionCodes[c][name]; ok {
+			return code
+		}
+		// The state may already be a code
+		for _, code := range subdivisionCodes[c] {
+			if strings.EqualFold(code, state) {
+				return code
+			}
+		}
+	}
+	return ""
+}
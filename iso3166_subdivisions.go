@@ -0,0 +1,6012 @@
+// Code generated by gen_subdivisions.go from iso-codes/json/iso_3166-2.json. DO NOT EDIT.
+
+package main
+
+// isoSubdivisions maps ISO 3166-1 alpha-2 country codes to the names of their ISO 3166-2
+// subdivisions and their codes without the country prefix. Names that only differ in case or
+// accents match the first one listed.
+var isoSubdivisions = map[string][]subdivisionName{
+	"AD": {
+		{"Andorra la Vella", "07"},
+		{"Canillo", "02"},
+		{"Encamp", "03"},
+		{"Escaldes-Engordany", "08"},
+		{"La Massana", "04"},
+		{"Ordino", "05"},
+		{"Sant Julià de Lòria", "06"},
+	},
+	"AE": {
+		{"Abū Z̧aby", "AZ"},
+		{"Al Fujayrah", "FU"},
+		{"Ash Shāriqah", "SH"},
+		{"Dubayy", "DU"},
+		{"Ra’s al Khaymah", "RK"},
+		{"Umm al Qaywayn", "UQ"},
+		{"‘Ajmān", "AJ"},
+	},
+	"AF": {
+		{"Badakhshān", "BDS"},
+		{"Baghlān", "BGL"},
+		{"Balkh", "BAL"},
+		{"Bādghīs", "BDG"},
+		{"Bāmyān", "BAM"},
+		{"Dāykundī", "DAY"},
+		{"Farāh", "FRA"},
+		{"Fāryāb", "FYB"},
+		{"Ghaznī", "GHA"},
+		{"Ghōr", "GHO"},
+		{"Helmand", "HEL"},
+		{"Herāt", "HER"},
+		{"Jowzjān", "JOW"},
+		{"Kandahār", "KAN"},
+		{"Khōst", "KHO"},
+		{"Kunaṟ", "KNR"},
+		{"Kunduz", "KDZ"},
+		{"Kābul", "KAB"},
+		{"Kāpīsā", "KAP"},
+		{"Laghmān", "LAG"},
+		{"Lōgar", "LOG"},
+		{"Nangarhār", "NAN"},
+		{"Nīmrōz", "NIM"},
+		{"Nūristān", "NUR"},
+		{"Paktiyā", "PIA"},
+		{"Paktīkā", "PKA"},
+		{"Panjshayr", "PAN"},
+		{"Parwān", "PAR"},
+		{"Samangān", "SAM"},
+		{"Sar-e Pul", "SAR"},
+		{"Takhār", "TAK"},
+		{"Uruzgān", "URU"},
+		{"Wardak", "WAR"},
+		{"Zābul", "ZAB"},
+	},
+	"AG": {
+		{"Barbuda", "10"},
+		{"Redonda", "11"},
+		{"Saint George", "03"},
+		{"Saint John", "04"},
+		{"Saint Mary", "05"},
+		{"Saint Paul", "06"},
+		{"Saint Peter", "07"},
+		{"Saint Philip", "08"},
+	},
+	"AL": {
+		{"Berat", "01"},
+		{"Dibër", "09"},
+		{"Durrës", "02"},
+		{"Elbasan", "03"},
+		{"Fier", "04"},
+		{"Gjirokastër", "05"},
+		{"Korçë", "06"},
+		{"Kukës", "07"},
+		{"Lezhë", "08"},
+		{"Shkodër", "10"},
+		{"Tiranë", "11"},
+		{"Vlorë", "12"},
+	},
+	"AM": {
+		{"Aragac̣otn", "AG"},
+		{"Ararat", "AR"},
+		{"Armavir", "AV"},
+		{"Erevan", "ER"},
+		{"Geġark'unik'", "GR"},
+		{"Kotayk'", "KT"},
+		{"Loṙi", "LO"},
+		{"Syunik'", "SU"},
+		{"Tavuš", "TV"},
+		{"Vayoć Jor", "VD"},
+		{"Širak", "SH"},
+	},
+	"AO": {
+		{"Bengo", "BGO"},
+		{"Benguela", "BGU"},
+		{"Bié", "BIE"},
+		{"Cabinda", "CAB"},
+		{"Cuando Cubango", "CCU"},
+		{"Cuanza-Norte", "CNO"},
+		{"Cuanza-Sul", "CUS"},
+		{"Cunene", "CNN"},
+		{"Huambo", "HUA"},
+		{"Huíla", "HUI"},
+		{"Luanda", "LUA"},
+		{"Lunda-Norte", "LNO"},
+		{"Lunda-Sul", "LSU"},
+		{"Malange", "MAL"},
+		{"Moxico", "MOX"},
+		{"Namibe", "NAM"},
+		{"Uíge", "UIG"},
+		{"Zaire", "ZAI"},
+	},
+	"AR": {
+		{"Buenos Aires", "B"},
+		{"Catamarca", "K"},
+		{"Chaco", "H"},
+		{"Chubut", "U"},
+		{"Ciudad Autónoma de Buenos Aires", "C"},
+		{"Corrientes", "W"},
+		{"Córdoba", "X"},
+		{"Entre Ríos", "E"},
+		{"Formosa", "P"},
+		{"Jujuy", "Y"},
+		{"La Pampa", "L"},
+		{"La Rioja", "F"},
+		{"Mendoza", "M"},
+		{"Misiones", "N"},
+		{"Neuquén", "Q"},
+		{"Río Negro", "R"},
+		{"Salta", "A"},
+		{"San Juan", "J"},
+		{"San Luis", "D"},
+		{"Santa Cruz", "Z"},
+		{"Santa Fe", "S"},
+		{"Santiago del Estero", "G"},
+		{"Tierra del Fuego", "V"},
+		{"Tucumán", "T"},
+	},
+	"AT": {
+		{"Burgenland", "1"},
+		{"Kärnten", "2"},
+		{"Niederösterreich", "3"},
+		{"Oberösterreich", "4"},
+		{"Salzburg", "5"},
+		{"Steiermark", "6"},
+		{"Tirol", "7"},
+		{"Vorarlberg", "8"},
+		{"Wien", "9"},
+	},
+	"AU": {
+		{"Australian Capital Territory", "ACT"},
+		{"New South Wales", "NSW"},
+		{"Northern Territory", "NT"},
+		{"Queensland", "QLD"},
+		{"South Australia", "SA"},
+		{"Tasmania", "TAS"},
+		{"Victoria", "VIC"},
+		{"Western Australia", "WA"},
+	},
+	"AZ": {
+		{"Abşeron", "ABS"},
+		{"Astara", "AST"},
+		{"Ağcabədi", "AGC"},
+		{"Ağdam", "AGM"},
+		{"Ağdaş", "AGS"},
+		{"Ağstafa", "AGA"},
+		{"Ağsu", "AGU"},
+		{"Bakı", "BA"},
+		{"Balakən", "BAL"},
+		{"Beyləqan", "BEY"},
+		{"Biləsuvar", "BIL"},
+		{"Bərdə", "BAR"},
+		{"Cəbrayıl", "CAB"},
+		{"Cəlilabad", "CAL"},
+		{"Daşkəsən", "DAS"},
+		{"Füzuli", "FUZ"},
+		{"Goranboy", "GOR"},
+		{"Göygöl", "GYG"},
+		{"Göyçay", "GOY"},
+		{"Gədəbəy", "GAD"},
+		{"Gəncə", "GA"},
+		{"Hacıqabul", "HAC"},
+		{"Kürdəmir", "KUR"},
+		{"Kəlbəcər", "KAL"},
+		{"Laçın", "LAC"},
+		{"Lerik", "LER"},
+		{"Lənkəran", "LA"},
+		{"Masallı", "MAS"},
+		{"Mingəçevir", "MI"},
+		{"Naftalan", "NA"},
+		{"Naxçıvan", "NX"},
+		{"Neftçala", "NEF"},
+		{"Oğuz", "OGU"},
+		{"Qax", "QAX"},
+		{"Qazax", "QAZ"},
+		{"Qobustan", "QOB"},
+		{"Quba", "QBA"},
+		{"Qubadlı", "QBI"},
+		{"Qusar", "QUS"},
+		{"Qəbələ", "QAB"},
+		{"Saatlı", "SAT"},
+		{"Sabirabad", "SAB"},
+		{"Salyan", "SAL"},
+		{"Samux", "SMX"},
+		{"Siyəzən", "SIY"},
+		{"Sumqayıt", "SM"},
+		{"Tovuz", "TOV"},
+		{"Tərtər", "TAR"},
+		{"Ucar", "UCA"},
+		{"Xankəndi", "XA"},
+		{"Xaçmaz", "XAC"},
+		{"Xocalı", "XCI"},
+		{"Xocavənd", "XVD"},
+		{"Xızı", "XIZ"},
+		{"Yardımlı", "YAR"},
+		{"Yevlax", "YE"},
+		{"Zaqatala", "ZAQ"},
+		{"Zəngilan", "ZAN"},
+		{"Zərdab", "ZAR"},
+		{"İmişli", "IMI"},
+		{"İsmayıllı", "ISM"},
+		{"Şabran", "SBN"},
+		{"Şamaxı", "SMI"},
+		{"Şirvan", "SR"},
+		{"Şuşa", "SUS"},
+		{"Şəki", "SA"},
+		{"Şəmkir", "SKR"},
+		{"Babək", "BAB"},
+		{"Culfa", "CUL"},
+		{"Kǝngǝrli", "KAN"},
+		{"Ordubad", "ORD"},
+		{"Sədərək", "SAD"},
+		{"Şahbuz", "SAH"},
+		{"Şərur", "SAR"},
+	},
+	"BA": {
+		{"Brčko distrikt", "BRC"},
+		{"Federacija Bosne i Hercegovine", "BIH"},
+		{"Republika Srpska", "SRP"},
+	},
+	"BB": {
+		{"Christ Church", "01"},
+		{"Saint Andrew", "02"},
+		{"Saint George", "03"},
+		{"Saint James", "04"},
+		{"Saint John", "05"},
+		{"Saint Joseph", "06"},
+		{"Saint Lucy", "07"},
+		{"Saint Michael", "08"},
+		{"Saint Peter", "09"},
+		{"Saint Philip", "10"},
+		{"Saint Thomas", "11"},
+	},
+	"BD": {
+		{"Barishal", "A"},
+		{"Chattogram", "B"},
+		{"Dhaka", "C"},
+		{"Khulna", "D"},
+		{"Mymensingh", "H"},
+		{"Rajshahi", "E"},
+		{"Rangpur", "F"},
+		{"Sylhet", "G"},
+		{"Bagerhat", "05"},
+		{"Bandarban", "01"},
+		{"Barguna", "02"},
+		{"Bhola", "07"},
+		{"Bogura", "03"},
+		{"Brahmanbaria", "04"},
+		{"Chandpur", "09"},
+		{"Chapai Nawabganj", "45"},
+		{"Chuadanga", "12"},
+		{"Cox's Bazar", "11"},
+		{"Cumilla", "08"},
+		{"Dinajpur", "14"},
+		{"Faridpur", "15"},
+		{"Feni", "16"},
+		{"Gaibandha", "19"},
+		{"Gazipur", "18"},
+		{"Gopalganj", "17"},
+		{"Habiganj", "20"},
+		{"Jamalpur", "21"},
+		{"Jashore", "22"},
+		{"Jhalakathi", "25"},
+		{"Jhenaidah", "23"},
+		{"Joypurhat", "24"},
+		{"Khagrachhari", "29"},
+		{"Kishoreganj", "26"},
+		{"Kurigram", "28"},
+		{"Kushtia", "30"},
+		{"Lakshmipur", "31"},
+		{"Lalmonirhat", "32"},
+		{"Madaripur", "36"},
+		{"Magura", "37"},
+		{"Manikganj", "33"},
+		{"Meherpur", "39"},
+		{"Moulvibazar", "38"},
+		{"Munshiganj", "35"},
+		{"Naogaon", "48"},
+		{"Narail", "43"},
+		{"Narayanganj", "40"},
+		{"Narsingdi", "42"},
+		{"Natore", "44"},
+		{"Netrakona", "41"},
+		{"Nilphamari", "46"},
+		{"Noakhali", "47"},
+		{"Pabna", "49"},
+		{"Panchagarh", "52"},
+		{"Patuakhali", "51"},
+		{"Pirojpur", "50"},
+		{"Rajbari", "53"},
+		{"Rangamati", "56"},
+		{"Satkhira", "58"},
+		{"Shariatpur", "62"},
+		{"Sherpur", "57"},
+		{"Sirajganj", "59"},
+		{"Sunamganj", "61"},
+		{"Tangail", "63"},
+		{"Thakurgaon", "64"},
+	},
+	"BE": {
+		{"Brussels Hoofdstedelijk Gewest", "BRU"},
+		{"Vlaams Gewest", "VLG"},
+		{"wallonne, Région", "WAL"},
+		{"Région wallonne", "WAL"},
+		{"wallonne", "WAL"},
+		{"Antwerpen", "VAN"},
+		{"Brabant wallon", "WBR"},
+		{"Hainaut", "WHT"},
+		{"Limburg", "VLI"},
+		{"Liège", "WLG"},
+		{"Luxembourg", "WLX"},
+		{"Namur", "WNA"},
+		{"Oost-Vlaanderen", "VOV"},
+		{"Vlaams-Brabant", "VBR"},
+		{"West-Vlaanderen", "VWV"},
+	},
+	"BF": {
+		{"Boucle du Mouhoun", "01"},
+		{"Cascades", "02"},
+		{"Centre", "03"},
+		{"Centre-Est", "04"},
+		{"Centre-Nord", "05"},
+		{"Centre-Ouest", "06"},
+		{"Centre-Sud", "07"},
+		{"Est", "08"},
+		{"Hauts-Bassins", "09"},
+		{"Nord", "10"},
+		{"Plateau-Central", "11"},
+		{"Sahel", "12"},
+		{"Sud-Ouest", "13"},
+		{"Balé", "BAL"},
+		{"Bam", "BAM"},
+		{"Banwa", "BAN"},
+		{"Bazèga", "BAZ"},
+		{"Bougouriba", "BGR"},
+		{"Boulgou", "BLG"},
+		{"Boulkiemdé", "BLK"},
+		{"Comoé", "COM"},
+		{"Ganzourgou", "GAN"},
+		{"Gnagna", "GNA"},
+		{"Gourma", "GOU"},
+		{"Houet", "HOU"},
+		{"Ioba", "IOB"},
+		{"Kadiogo", "KAD"},
+		{"Komondjari", "KMD"},
+		{"Kompienga", "KMP"},
+		{"Kossi", "KOS"},
+		{"Koulpélogo", "KOP"},
+		{"Kouritenga", "KOT"},
+		{"Kourwéogo", "KOW"},
+		{"Kénédougou", "KEN"},
+		{"Loroum", "LOR"},
+		{"Léraba", "LER"},
+		{"Mouhoun", "MOU"},
+		{"Nahouri", "NAO"},
+		{"Namentenga", "NAM"},
+		{"Nayala", "NAY"},
+		{"Noumbiel", "NOU"},
+		{"Oubritenga", "OUB"},
+		{"Oudalan", "OUD"},
+		{"Passoré", "PAS"},
+		{"Poni", "PON"},
+		{"Sanguié", "SNG"},
+		{"Sanmatenga", "SMT"},
+		{"Sissili", "SIS"},
+		{"Soum", "SOM"},
+		{"Sourou", "SOR"},
+		{"Séno", "SEN"},
+		{"Tapoa", "TAP"},
+		{"Tuy", "TUI"},
+		{"Yagha", "YAG"},
+		{"Yatenga", "YAT"},
+		{"Ziro", "ZIR"},
+		{"Zondoma", "ZON"},
+		{"Zoundwéogo", "ZOU"},
+	},
+	"BG": {
+		{"Blagoevgrad", "01"},
+		{"Burgas", "02"},
+		{"Dobrich", "08"},
+		{"Gabrovo", "07"},
+		{"Haskovo", "26"},
+		{"Kardzhali", "09"},
+		{"Kyustendil", "10"},
+		{"Lovech", "11"},
+		{"Montana", "12"},
+		{"Pazardzhik", "13"},
+		{"Pernik", "14"},
+		{"Pleven", "15"},
+		{"Plovdiv", "16"},
+		{"Razgrad", "17"},
+		{"Ruse", "18"},
+		{"Shumen", "27"},
+		{"Silistra", "19"},
+		{"Sliven", "20"},
+		{"Smolyan", "21"},
+		{"Sofia", "23"},
+		{"Sofia (stolitsa)", "22"},
+		{"Stara Zagora", "24"},
+		{"Targovishte", "25"},
+		{"Varna", "03"},
+		{"Veliko Tarnovo", "04"},
+		{"Vidin", "05"},
+		{"Vratsa", "06"},
+		{"Yambol", "28"},
+		{"Благоевград", "01"},
+		{"Бургас", "02"},
+		{"Варна", "03"},
+		{"Велико Търново", "04"},
+		{"Видин", "05"},
+		{"Враца", "06"},
+		{"Габрово", "07"},
+		{"Добрич", "08"},
+		{"Кърджали", "09"},
+		{"Кюстендил", "10"},
+		{"Ловеч", "11"},
+		{"Монтана", "12"},
+		{"Пазарджик", "13"},
+		{"Перник", "14"},
+		{"Плевен", "15"},
+		{"Пловдив", "16"},
+		{"Разград", "17"},
+		{"Русе", "18"},
+		{"Силистра", "19"},
+		{"Сливен", "20"},
+		{"Смолян", "21"},
+		{"София", "23"},
+		{"Стара Загора", "24"},
+		{"Търговище", "25"},
+		{"Хасково", "26"},
+		{"Шумен", "27"},
+		{"Ямбол", "28"},
+	},
+	"BH": {
+		{"Al Janūbīyah", "14"},
+		{"Al Muḩarraq", "15"},
+		{"Al ‘Āşimah", "13"},
+		{"Ash Shamālīyah", "17"},
+	},
+	"BI": {
+		{"Bubanza", "BB"},
+		{"Bujumbura Mairie", "BM"},
+		{"Bujumbura Rural", "BL"},
+		{"Bururi", "BR"},
+		{"Cankuzo", "CA"},
+		{"Cibitoke", "CI"},
+		{"Gitega", "GI"},
+		{"Karuzi", "KR"},
+		{"Kayanza", "KY"},
+		{"Kirundo", "KI"},
+		{"Makamba", "MA"},
+		{"Muramvya", "MU"},
+		{"Muyinga", "MY"},
+		{"Mwaro", "MW"},
+		{"Ngozi", "NG"},
+		{"Rumonge", "RM"},
+		{"Rutana", "RT"},
+		{"Ruyigi", "RY"},
+	},
+	"BJ": {
+		{"Alibori", "AL"},
+		{"Atacora", "AK"},
+		{"Atlantique", "AQ"},
+		{"Borgou", "BO"},
+		{"Collines", "CO"},
+		{"Couffo", "KO"},
+		{"Donga", "DO"},
+		{"Littoral", "LI"},
+		{"Mono", "MO"},
+		{"Ouémé", "OU"},
+		{"Plateau", "PL"},
+		{"Zou", "ZO"},
+	},
+	"BN": {
+		{"Belait", "BE"},
+		{"Brunei-Muara", "BM"},
+		{"Temburong", "TE"},
+		{"Tutong", "TU"},
+	},
+	"BO": {
+		{"Chuquisaca", "H"},
+		{"Cochabamba", "C"},
+		{"El Beni", "B"},
+		{"La Paz", "L"},
+		{"Oruro", "O"},
+		{"Pando", "N"},
+		{"Potosí", "P"},
+		{"Santa Cruz", "S"},
+		{"Tarija", "T"},
+	},
+	"BQ": {
+		{"Bonaire", "BO"},
+		{"Saba", "SA"},
+		{"Sint Eustatius", "SE"},
+	},
+	"BR": {
+		{"Acre", "AC"},
+		{"Alagoas", "AL"},
+		{"Amapá", "AP"},
+		{"Amazonas", "AM"},
+		{"Bahia", "BA"},
+		{"Ceará", "CE"},
+		{"Distrito Federal", "DF"},
+		{"Espírito Santo", "ES"},
+		{"Goiás", "GO"},
+		{"Maranhão", "MA"},
+		{"Mato Grosso", "MT"},
+		{"Mato Grosso do Sul", "MS"},
+		{"Minas Gerais", "MG"},
+		{"Paraná", "PR"},
+		{"Paraíba", "PB"},
+		{"Pará", "PA"},
+		{"Pernambuco", "PE"},
+		{"Piauí", "PI"},
+		{"Rio Grande do Norte", "RN"},
+		{"Rio Grande do Sul", "RS"},
+		{"Rio de Janeiro", "RJ"},
+		{"Rondônia", "RO"},
+		{"Roraima", "RR"},
+		{"Santa Catarina", "SC"},
+		{"Sergipe", "SE"},
+		{"São Paulo", "SP"},
+		{"Tocantins", "TO"},
+	},
+	"BS": {
+		{"Acklins", "AK"},
+		{"Berry Islands", "BY"},
+		{"Bimini", "BI"},
+		{"Black Point", "BP"},
+		{"Cat Island", "CI"},
+		{"Central Abaco", "CO"},
+		{"Central Andros", "CS"},
+		{"Central Eleuthera", "CE"},
+		{"City of Freeport", "FP"},
+		{"Crooked Island and Long Cay", "CK"},
+		{"East Grand Bahama", "EG"},
+		{"Exuma", "EX"},
+		{"Grand Cay", "GC"},
+		{"Harbour Island", "HI"},
+		{"Hope Town", "HT"},
+		{"Inagua", "IN"},
+		{"Long Island", "LI"},
+		{"Mangrove Cay", "MC"},
+		{"Mayaguana", "MG"},
+		{"Moore's Island", "MI"},
+		{"New Providence", "NP"},
+		{"North Abaco", "NO"},
+		{"North Andros", "NS"},
+		{"North Eleuthera", "NE"},
+		{"Ragged Island", "RI"},
+		{"Rum Cay", "RC"},
+		{"San Salvador", "SS"},
+		{"South Abaco", "SO"},
+		{"South Andros", "SA"},
+		{"South Eleuthera", "SE"},
+		{"Spanish Wells", "SW"},
+		{"West Grand Bahama", "WG"},
+	},
+	"BT": {
+		{"Bumthang", "33"},
+		{"Chhukha", "12"},
+		{"Dagana", "22"},
+		{"Gasa", "GA"},
+		{"Haa", "13"},
+		{"Lhuentse", "44"},
+		{"Monggar", "42"},
+		{"Paro", "11"},
+		{"Pema Gatshel", "43"},
+		{"Punakha", "23"},
+		{"Samdrup Jongkhar", "45"},
+		{"Samtse", "14"},
+		{"Sarpang", "31"},
+		{"Thimphu", "15"},
+		{"Trashi Yangtse", "TY"},
+		{"Trashigang", "41"},
+		{"Trongsa", "32"},
+		{"Tsirang", "21"},
+		{"Wangdue Phodrang", "24"},
+		{"Zhemgang", "34"},
+	},
+	"BW": {
+		{"Central", "CE"},
+		{"Chobe", "CH"},
+		{"Francistown", "FR"},
+		{"Gaborone", "GA"},
+		{"Ghanzi", "GH"},
+		{"Jwaneng", "JW"},
+		{"Kgalagadi", "KG"},
+		{"Kgatleng", "KL"},
+		{"Kweneng", "KW"},
+		{"Lobatse", "LO"},
+		{"North East", "NE"},
+		{"North West", "NW"},
+		{"Selibe Phikwe", "SP"},
+		{"South East", "SE"},
+		{"Southern", "SO"},
+		{"Sowa Town", "ST"},
+	},
+	"BY": {
+		{"Bresckaja voblasć", "BR"},
+		{"Gomel'skaja oblast'", "HO"},
+		{"Gorod Minsk", "HM"},
+		{"Grodnenskaja oblast'", "HR"},
+		{"Mahilioŭskaja voblasć", "MA"},
+		{"Minskaja oblast'", "MI"},
+		{"Viciebskaja voblasć", "VI"},
+		{"Брэсцкая вобласць", "BR"},
+		{"Віцебская вобласць", "VI"},
+		{"Гомельская вобласць", "HO"},
+		{"Горад Мінск", "HM"},
+		{"Гродзенская вобласць", "HR"},
+		{"Магілёўская вобласць", "MA"},
+		{"Мінская вобласць", "MI"},
+	},
+	"BZ": {
+		{"Belize", "BZ"},
+		{"Cayo", "CY"},
+		{"Corozal", "CZL"},
+		{"Orange Walk", "OW"},
+		{"Stann Creek", "SC"},
+		{"Toledo", "TOL"},
+	},
+	"CA": {
+		{"Alberta", "AB"},
+		{"British Columbia", "BC"},
+		{"Manitoba", "MB"},
+		{"New Brunswick", "NB"},
+		{"Newfoundland and Labrador", "NL"},
+		{"Northwest Territories", "NT"},
+		{"Nova Scotia", "NS"},
+		{"Nunavut", "NU"},
+		{"Ontario", "ON"},
+		{"Prince Edward Island", "PE"},
+		{"Quebec", "QC"},
+		{"Saskatchewan", "SK"},
+		{"Yukon", "YT"},
+	},
+	"CD": {
+		{"Bas-Uélé", "BU"},
+		{"Haut-Katanga", "HK"},
+		{"Haut-Lomami", "HL"},
+		{"Haut-Uélé", "HU"},
+		{"Ituri", "IT"},
+		{"Kasaï", "KS"},
+		{"Kasaï Central", "KC"},
+		{"Kasaï Oriental", "KE"},
+		{"Kinshasa", "KN"},
+		{"Kongo Central", "BC"},
+		{"Kwango", "KG"},
+		{"Kwilu", "KL"},
+		{"Lomami", "LO"},
+		{"Lualaba", "LU"},
+		{"Mai-Ndombe", "MN"},
+		{"Maniema", "MA"},
+		{"Mongala", "MO"},
+		{"Nord-Kivu", "NK"},
+		{"Nord-Ubangi", "NU"},
+		{"Sankuru", "SA"},
+		{"Sud-Kivu", "SK"},
+		{"Sud-Ubangi", "SU"},
+		{"Tanganyika", "TA"},
+		{"Tshopo", "TO"},
+		{"Tshuapa", "TU"},
+		{"Équateur", "EQ"},
+	},
+	"CF": {
+		{"Bamingui-Bangoran", "BB"},
+		{"Bangui", "BGF"},
+		{"Basse-Kotto", "BK"},
+		{"Gribingui", "KB"},
+		{"Haut-Mbomou", "HM"},
+		{"Haute-Kotto", "HK"},
+		{"Haute-Sangha / Mambéré-Kadéï", "HS"},
+		{"Kemö-Gïrïbïngï", "KG"},
+		{"Lobaye", "LB"},
+		{"Mbomou", "MB"},
+		{"Nana-Mambéré", "NM"},
+		{"Ombella-Mpoko", "MP"},
+		{"Ouaka", "UK"},
+		{"Ouham", "AC"},
+		{"Ouham-Pendé", "OP"},
+		{"Sangha", "SE"},
+		{"Vakaga", "VK"},
+		{"Haute-Sangha", "HS"},
+		{"Mambéré-Kadéï", "HS"},
+	},
+	"CG": {
+		{"Bouenza", "11"},
+		{"Brazzaville", "BZV"},
+		{"Cuvette", "8"},
+		{"Cuvette-Ouest", "15"},
+		{"Kouilou", "5"},
+		{"Likouala", "7"},
+		{"Lékoumou", "2"},
+		{"Niari", "9"},
+		{"Plateaux", "14"},
+		{"Pointe-Noire", "16"},
+		{"Pool", "12"},
+		{"Sangha", "13"},
+	},
+	"CH": {
+		{"Aargau", "AG"},
+		{"Appenzell Ausserrhoden", "AR"},
+		{"Appenzell Innerrhoden", "AI"},
+		{"Basel-Landschaft", "BL"},
+		{"Basel-Stadt", "BS"},
+		{"Bern", "BE"},
+		{"Freiburg", "FR"},
+		{"Genève", "GE"},
+		{"Glarus", "GL"},
+		{"Graubünden", "GR"},
+		{"Jura", "JU"},
+		{"Luzern", "LU"},
+		{"Neuchâtel", "NE"},
+		{"Nidwalden", "NW"},
+		{"Obwalden", "OW"},
+		{"Sankt Gallen", "SG"},
+		{"Schaffhausen", "SH"},
+		{"Schwyz", "SZ"},
+		{"Solothurn", "SO"},
+		{"Thurgau", "TG"},
+		{"Ticino", "TI"},
+		{"Uri", "UR"},
+		{"Valais", "VS"},
+		{"Vaud", "VD"},
+		{"Zug", "ZG"},
+		{"Zürich", "ZH"},
+	},
+	"CI": {
+		{"Abidjan", "AB"},
+		{"Bas-Sassandra", "BS"},
+		{"Comoé", "CM"},
+		{"Denguélé", "DN"},
+		{"Gôh-Djiboua", "GD"},
+		{"Lacs", "LC"},
+		{"Lagunes", "LG"},
+		{"Montagnes", "MG"},
+		{"Sassandra-Marahoué", "SM"},
+		{"Savanes", "SV"},
+		{"Vallée du Bandama", "VB"},
+		{"Woroba", "WR"},
+		{"Yamoussoukro", "YM"},
+		{"Zanzan", "ZZ"},
+	},
+	"CL": {
+		{"Aisén del General Carlos Ibañez del Campo", "AI"},
+		{"Antofagasta", "AN"},
+		{"Arica y Parinacota", "AP"},
+		{"Atacama", "AT"},
+		{"Biobío", "BI"},
+		{"Coquimbo", "CO"},
+		{"La Araucanía", "AR"},
+		{"Libertador General Bernardo O'Higgins", "LI"},
+		{"Los Lagos", "LL"},
+		{"Los Ríos", "LR"},
+		{"Magallanes", "MA"},
+		{"Maule", "ML"},
+		{"Región Metropolitana de Santiago", "RM"},
+		{"Tarapacá", "TA"},
+		{"Valparaíso", "VS"},
+		{"Ñuble", "NB"},
+	},
+	"CM": {
+		{"Adamaoua", "AD"},
+		{"Centre", "CE"},
+		{"East", "ES"},
+		{"Far North", "EN"},
+		{"Littoral", "LT"},
+		{"North", "NO"},
+		{"North-West", "NW"},
+		{"South", "SU"},
+		{"South-West", "SW"},
+		{"West", "OU"},
+	},
+	"CN": {
+		{"Anhui Sheng", "AH"},
+		{"Beijing Shi", "BJ"},
+		{"Chongqing Shi", "CQ"},
+		{"Fujian Sheng", "FJ"},
+		{"Gansu Sheng", "GS"},
+		{"Guangdong Sheng", "GD"},
+		{"Guangxi Zhuangzu Zizhiqu", "GX"},
+		{"Guizhou Sheng", "GZ"},
+		{"Hainan Sheng", "HI"},
+		{"Hebei Sheng", "HE"},
+		{"Heilongjiang Sheng", "HL"},
+		{"Henan Sheng", "HA"},
+		{"Hong Kong SAR", "HK"},
+		{"Hubei Sheng", "HB"},
+		{"Hunan Sheng", "HN"},
+		{"Jiangsu Sheng", "JS"},
+		{"Jiangxi Sheng", "JX"},
+		{"Jilin Sheng", "JL"},
+		{"Liaoning Sheng", "LN"},
+		{"Macao SAR", "MO"},
+		{"Nei Mongol Zizhiqu", "NM"},
+		{"Ningxia Huizi Zizhiqu", "NX"},
+		{"Qinghai Sheng", "QH"},
+		{"Shaanxi Sheng", "SN"},
+		{"Shandong Sheng", "SD"},
+		{"Shanghai Shi", "SH"},
+		{"Shanxi Sheng", "SX"},
+		{"Sichuan Sheng", "SC"},
+		{"Taiwan Sheng", "TW"},
+		{"Tianjin Shi", "TJ"},
+		{"Xinjiang Uygur Zizhiqu", "XJ"},
+		{"Xizang Zizhiqu", "XZ"},
+		{"Yunnan Sheng", "YN"},
+		{"Zhejiang Sheng", "ZJ"},
+		{"上海市", "SH"},
+		{"云南省", "YN"},
+		{"内蒙古自治区", "NM"},
+		{"北京市", "BJ"},
+		{"台湾省", "TW"},
+		{"吉林省", "JL"},
+		{"四川省", "SC"},
+		{"天津市", "TJ"},
+		{"宁夏回族自治区", "NX"},
+		{"安徽省", "AH"},
+		{"山东省", "SD"},
+		{"山西省", "SX"},
+		{"广东省", "GD"},
+		{"广西壮族自治区", "GX"},
+		{"新疆维吾尔自治区", "XJ"},
+		{"江苏省", "JS"},
+		{"江西省", "JX"},
+		{"河北省", "HE"},
+		{"河南省", "HA"},
+		{"浙江省", "ZJ"},
+		{"海南省", "HI"},
+		{"湖北省", "HB"},
+		{"湖南省", "HN"},
+		{"澳门特别行政区", "MO"},
+		{"甘肃省", "GS"},
+		{"福建省", "FJ"},
+		{"西藏自治区", "XZ"},
+		{"贵州省", "GZ"},
+		{"辽宁省", "LN"},
+		{"重庆市", "CQ"},
+		{"陕西省", "SN"},
+		{"青海省", "QH"},
+		{"香港特别行政区", "HK"},
+		{"黑龙江省", "HL"},
+	},
+	"CO": {
+		{"Amazonas", "AMA"},
+		{"Antioquia", "ANT"},
+		{"Arauca", "ARA"},
+		{"Atlántico", "ATL"},
+		{"Bolívar", "BOL"},
+		{"Boyacá", "BOY"},
+		{"Caldas", "CAL"},
+		{"Caquetá", "CAQ"},
+		{"Casanare", "CAS"},
+		{"Cauca", "CAU"},
+		{"Cesar", "CES"},
+		{"Chocó", "CHO"},
+		{"Cundinamarca", "CUN"},
+		{"Córdoba", "COR"},
+		{"Distrito Capital de Bogotá", "DC"},
+		{"Guainía", "GUA"},
+		{"Guaviare", "GUV"},
+		{"Huila", "HUI"},
+		{"La Guajira", "LAG"},
+		{"Magdalena", "MAG"},
+		{"Meta", "MET"},
+		{"Nariño", "NAR"},
+		{"Norte de Santander", "NSA"},
+		{"Putumayo", "PUT"},
+		{"Quindío", "QUI"},
+		{"Risaralda", "RIS"},
+		{"San Andrés, Providencia y Santa Catalina", "SAP"},
+		{"Santander", "SAN"},
+		{"Sucre", "SUC"},
+		{"Tolima", "TOL"},
+		{"Valle del Cauca", "VAC"},
+		{"Vaupés", "VAU"},
+		{"Vichada", "VID"},
+		{"Providencia y Santa Catalina San Andrés", "SAP"},
+		{"San Andrés", "SAP"},
+	},
+	"CR": {
+		{"Alajuela", "A"},
+		{"Cartago", "C"},
+		{"Guanacaste", "G"},
+		{"Heredia", "H"},
+		{"Limón", "L"},
+		{"Puntarenas", "P"},
+		{"San José", "SJ"},
+	},
+	"CU": {
+		{"Artemisa", "15"},
+		{"Camagüey", "09"},
+		{"Ciego de Ávila", "08"},
+		{"Cienfuegos", "06"},
+		{"Granma", "12"},
+		{"Guantánamo", "14"},
+		{"Holguín", "11"},
+		{"Isla de la Juventud", "99"},
+		{"La Habana", "03"},
+		{"Las Tunas", "10"},
+		{"Matanzas", "04"},
+		{"Mayabeque", "16"},
+		{"Pinar del Río", "01"},
+		{"Sancti Spíritus", "07"},
+		{"Santiago de Cuba", "13"},
+		{"Villa Clara", "05"},
+	},
+	"CV": {
+		{"Ilhas de Barlavento", "B"},
+		{"Ilhas de Sotavento", "S"},
+		{"Boa Vista", "BV"},
+		{"Brava", "BR"},
+		{"Maio", "MA"},
+		{"Mosteiros", "MO"},
+		{"Paul", "PA"},
+		{"Porto Novo", "PN"},
+		{"Praia", "PR"},
+		{"Ribeira Brava", "RB"},
+		{"Ribeira Grande", "RG"},
+		{"Ribeira Grande de Santiago", "RS"},
+		{"Sal", "SL"},
+		{"Santa Catarina", "CA"},
+		{"Santa Catarina do Fogo", "CF"},
+		{"Santa Cruz", "CR"},
+		{"São Domingos", "SD"},
+		{"São Filipe", "SF"},
+		{"São Lourenço dos Órgãos", "SO"},
+		{"São Miguel", "SM"},
+		{"São Salvador do Mundo", "SS"},
+		{"São Vicente", "SV"},
+		{"Tarrafal", "TA"},
+		{"Tarrafal de São Nicolau", "TS"},
+	},
+	"CY": {
+		{"Ammochostos", "04"},
+		{"Baf", "05"},
+		{"Girne", "06"},
+		{"Larnaka", "03"},
+		{"Lefkosia", "01"},
+		{"Lemesos", "02"},
+	},
+	"CZ": {
+		{"Jihomoravský kraj", "64"},
+		{"Jihočeský kraj", "31"},
+		{"Karlovarský kraj", "41"},
+		{"Kraj Vysočina", "63"},
+		{"Královéhradecký kraj", "52"},
+		{"Liberecký kraj", "51"},
+		{"Moravskoslezský kraj", "80"},
+		{"Olomoucký kraj", "71"},
+		{"Pardubický kraj", "53"},
+		{"Plzeňský kraj", "32"},
+		{"Praha, Hlavní město", "10"},
+		{"Středočeský kraj", "20"},
+		{"Zlínský kraj", "72"},
+		{"Ústecký kraj", "42"},
+		{"Hlavní město Praha", "10"},
+		{"Praha", "10"},
+		{"Benešov", "201"},
+		{"Beroun", "202"},
+		{"Blansko", "641"},
+		{"Brno-město", "642"},
+		{"Brno-venkov", "643"},
+		{"Bruntál", "801"},
+		{"Břeclav", "644"},
+		{"Cheb", "411"},
+		{"Chomutov", "422"},
+		{"Chrudim", "531"},
+		{"Domažlice", "321"},
+		{"Děčín", "421"},
+		{"Frýdek-Místek", "802"},
+		{"Havlíčkův Brod", "631"},
+		{"Hodonín", "645"},
+		{"Hradec Králové", "521"},
+		{"Jablonec nad Nisou", "512"},
+		{"Jeseník", "711"},
+		{"Jihlava", "632"},
+		{"Jindřichův Hradec", "313"},
+		{"Jičín", "522"},
+		{"Karlovy Vary", "412"},
+		{"Karviná", "803"},
+		{"Kladno", "203"},
+		{"Klatovy", "322"},
+		{"Kolín", "204"},
+		{"Kroměříž", "721"},
+		{"Kutná Hora", "205"},
+		{"Liberec", "513"},
+		{"Litoměřice", "423"},
+		{"Louny", "424"},
+		{"Mladá Boleslav", "207"},
+		{"Most", "425"},
+		{"Mělník", "206"},
+		{"Nový Jičín", "804"},
+		{"Nymburk", "208"},
+		{"Náchod", "523"},
+		{"Olomouc", "712"},
+		{"Opava", "805"},
+		{"Ostrava-město", "806"},
+		{"Pardubice", "532"},
+		{"Pelhřimov", "633"},
+		{"Plzeň-jih", "324"},
+		{"Plzeň-město", "323"},
+		{"Plzeň-sever", "325"},
+		{"Prachatice", "315"},
+		{"Praha-východ", "209"},
+		{"Praha-západ", "20A"},
+		{"Prostějov", "713"},
+		{"Písek", "314"},
+		{"Přerov", "714"},
+		{"Příbram", "20B"},
+		{"Rakovník", "20C"},
+		{"Rokycany", "326"},
+		{"Rychnov nad Kněžnou", "524"},
+		{"Semily", "514"},
+		{"Sokolov", "413"},
+		{"Strakonice", "316"},
+		{"Svitavy", "533"},
+		{"Tachov", "327"},
+		{"Teplice", "426"},
+		{"Trutnov", "525"},
+		{"Tábor", "317"},
+		{"Třebíč", "634"},
+		{"Uherské Hradiště", "722"},
+		{"Vsetín", "723"},
+		{"Vyškov", "646"},
+		{"Zlín", "724"},
+		{"Znojmo", "647"},
+		{"Ústí nad Labem", "427"},
+		{"Ústí nad Orlicí", "534"},
+		{"Česká Lípa", "511"},
+		{"České Budějovice", "311"},
+		{"Český Krumlov", "312"},
+		{"Šumperk", "715"},
+		{"Žďár nad Sázavou", "635"},
+	},
+	"DE": {
+		{"Baden-Württemberg", "BW"},
+		{"Bayern", "BY"},
+		{"Berlin", "BE"},
+		{"Brandenburg", "BB"},
+		{"Bremen", "HB"},
+		{"Hamburg", "HH"},
+		{"Hessen", "HE"},
+		{"Mecklenburg-Vorpommern", "MV"},
+		{"Niedersachsen", "NI"},
+		{"Nordrhein-Westfalen", "NW"},
+		{"Rheinland-Pfalz", "RP"},
+		{"Saarland", "SL"},
+		{"Sachsen", "SN"},
+		{"Sachsen-Anhalt", "ST"},
+		{"Schleswig-Holstein", "SH"},
+		{"Thüringen", "TH"},
+	},
+	"DJ": {
+		{"Ali Sabieh", "AS"},
+		{"Arta", "AR"},
+		{"Awbūk", "OB"},
+		{"Dikhil", "DI"},
+		{"Djibouti", "DJ"},
+		{"Tadjourah", "TA"},
+	},
+	"DK": {
+		{"Hovedstaden", "84"},
+		{"Midtjylland", "82"},
+		{"Nordjylland", "81"},
+		{"Sjælland", "85"},
+		{"Syddanmark", "83"},
+	},
+	"DM": {
+		{"Saint Andrew", "02"},
+		{"Saint David", "03"},
+		{"Saint George", "04"},
+		{"Saint John", "05"},
+		{"Saint Joseph", "06"},
+		{"Saint Luke", "07"},
+		{"Saint Mark", "08"},
+		{"Saint Patrick", "09"},
+		{"Saint Paul", "10"},
+		{"Saint Peter", "11"},
+	},
+	"DO": {
+		{"Cibao Nordeste", "33"},
+		{"Cibao Noroeste", "34"},
+		{"Cibao Norte", "35"},
+		{"Cibao Sur", "36"},
+		{"El Valle", "37"},
+		{"Enriquillo", "38"},
+		{"Higuamo", "39"},
+		{"Ozama", "40"},
+		{"Valdesia", "41"},
+		{"Yuma", "42"},
+		{"Azua", "02"},
+		{"Baoruco", "03"},
+		{"Barahona", "04"},
+		{"Dajabón", "05"},
+		{"Distrito Nacional (Santo Domingo)", "01"},
+		{"Duarte", "06"},
+		{"El Seibo", "08"},
+		{"Elías Piña", "07"},
+		{"Espaillat", "09"},
+		{"Hato Mayor", "30"},
+		{"Hermanas Mirabal", "19"},
+		{"Independencia", "10"},
+		{"La Altagracia", "11"},
+		{"La Romana", "12"},
+		{"La Vega", "13"},
+		{"María Trinidad Sánchez", "14"},
+		{"Monseñor Nouel", "28"},
+		{"Monte Cristi", "15"},
+		{"Monte Plata", "29"},
+		{"Pedernales", "16"},
+		{"Peravia", "17"},
+		{"Puerto Plata", "18"},
+		{"Samaná", "20"},
+		{"San Cristóbal", "21"},
+		{"San José de Ocoa", "31"},
+		{"San Juan", "22"},
+		{"San Pedro de Macorís", "23"},
+		{"Santiago", "25"},
+		{"Santiago Rodríguez", "26"},
+		{"Santo Domingo", "32"},
+		{"Sánchez Ramírez", "24"},
+		{"Valverde", "27"},
+		{"Distrito Nacional", "01"},
+	},
+	"DZ": {
+		{"Adrar", "01"},
+		{"Alger", "16"},
+		{"Annaba", "23"},
+		{"Aïn Defla", "44"},
+		{"Aïn Témouchent", "46"},
+		{"Batna", "05"},
+		{"Biskra", "07"},
+		{"Blida", "09"},
+		{"Bordj Bou Arréridj", "34"},
+		{"Bouira", "10"},
+		{"Boumerdès", "35"},
+		{"Béchar", "08"},
+		{"Béjaïa", "06"},
+		{"Chlef", "02"},
+		{"Constantine", "25"},
+		{"Djelfa", "17"},
+		{"El Bayadh", "32"},
+		{"El Oued", "39"},
+		{"El Tarf", "36"},
+		{"Ghardaïa", "47"},
+		{"Guelma", "24"},
+		{"Illizi", "33"},
+		{"Jijel", "18"},
+		{"Khenchela", "40"},
+		{"Laghouat", "03"},
+		{"M'sila", "28"},
+		{"Mascara", "29"},
+		{"Mila", "43"},
+		{"Mostaganem", "27"},
+		{"Médéa", "26"},
+		{"Naama", "45"},
+		{"Oran", "31"},
+		{"Ouargla", "30"},
+		{"Oum el Bouaghi", "04"},
+		{"Relizane", "48"},
+		{"Saïda", "20"},
+		{"Sidi Bel Abbès", "22"},
+		{"Skikda", "21"},
+		{"Souk Ahras", "41"},
+		{"Sétif", "19"},
+		{"Tamanrasset", "11"},
+		{"Tiaret", "14"},
+		{"Tindouf", "37"},
+		{"Tipaza", "42"},
+		{"Tissemsilt", "38"},
+		{"Tizi Ouzou", "15"},
+		{"Tlemcen", "13"},
+		{"Tébessa", "12"},
+	},
+	"EC": {
+		{"Azuay", "A"},
+		{"Bolívar", "B"},
+		{"Carchi", "C"},
+		{"Cañar", "F"},
+		{"Chimborazo", "H"},
+		{"Cotopaxi", "X"},
+		{"El Oro", "O"},
+		{"Esmeraldas", "E"},
+		{"Galápagos", "W"},
+		{"Guayas", "G"},
+		{"Imbabura", "I"},
+		{"Loja", "L"},
+		{"Los Ríos", "R"},
+		{"Manabí", "M"},
+		{"Morona Santiago", "S"},
+		{"Napo", "N"},
+		{"Orellana", "D"},
+		{"Pastaza", "Y"},
+		{"Pichincha", "P"},
+		{"Santa Elena", "SE"},
+		{"Santo Domingo de los Tsáchilas", "SD"},
+		{"Sucumbíos", "U"},
+		{"Tungurahua", "T"},
+		{"Zamora Chinchipe", "Z"},
+	},
+	"EE": {
+		{"Harjumaa", "37"},
+		{"Hiiumaa", "39"},
+		{"Ida-Virumaa", "45"},
+		{"Järvamaa", "52"},
+		{"Jõgevamaa", "50"},
+		{"Lääne-Virumaa", "60"},
+		{"Läänemaa", "56"},
+		{"Pärnumaa", "68"},
+		{"Põlvamaa", "64"},
+		{"Raplamaa", "71"},
+		{"Saaremaa", "74"},
+		{"Tartumaa", "79"},
+		{"Valgamaa", "81"},
+		{"Viljandimaa", "84"},
+		{"Võrumaa", "87"},
+		{"Alutaguse", "130"},
+		{"Anija", "141"},
+		{"Antsla", "142"},
+		{"Elva", "171"},
+		{"Haapsalu", "184"},
+		{"Haljala", "191"},
+		{"Harku", "198"},
+		{"Häädemeeste", "214"},
+		{"Järva", "255"},
+		{"Jõelähtme", "245"},
+		{"Jõgeva", "247"},
+		{"Jõhvi", "251"},
+		{"Kadrina", "272"},
+		{"Kambja", "283"},
+		{"Kanepi", "284"},
+		{"Kastre", "291"},
+		{"Kehtna", "293"},
+		{"Keila", "296"},
+		{"Kihnu", "303"},
+		{"Kiili", "305"},
+		{"Kohila", "317"},
+		{"Kohtla-Järve", "321"},
+		{"Kose", "338"},
+		{"Kuusalu", "353"},
+		{"Loksa", "424"},
+		{"Luunja", "432"},
+		{"Lääne-Harju", "431"},
+		{"Lääne-Nigula", "441"},
+		{"Lääneranna", "430"},
+		{"Lüganuse", "442"},
+		{"Maardu", "446"},
+		{"Muhu", "478"},
+		{"Mulgi", "480"},
+		{"Mustvee", "486"},
+		{"Märjamaa", "503"},
+		{"Narva", "511"},
+		{"Narva-Jõesuu", "514"},
+		{"Nõo", "528"},
+		{"Otepää", "557"},
+		{"Paide", "567"},
+		{"Peipsiääre", "586"},
+		{"Pärnu", "624"},
+		{"Põhja-Pärnumaa", "638"},
+		{"Põhja-Sakala", "615"},
+		{"Põltsamaa", "618"},
+		{"Põlva", "622"},
+		{"Raasiku", "651"},
+		{"Rae", "653"},
+		{"Rakvere", "661"},
+		{"Rapla", "668"},
+		{"Ruhnu", "689"},
+		{"Räpina", "708"},
+		{"Rõuge", "698"},
+		{"Saarde", "712"},
+		{"Saku", "719"},
+		{"Saue", "726"},
+		{"Setomaa", "732"},
+		{"Sillamäe", "735"},
+		{"Tallinn", "784"},
+		{"Tapa", "792"},
+		{"Tartu", "793"},
+		{"Toila", "803"},
+		{"Tori", "809"},
+		{"Tõrva", "824"},
+		{"Türi", "834"},
+		{"Valga", "855"},
+		{"Viimsi", "890"},
+		{"Viljandi", "897"},
+		{"Vinni", "901"},
+		{"Viru-Nigula", "903"},
+		{"Vormsi", "907"},
+		{"Väike-Maarja", "928"},
+		{"Võru", "917"},
+	},
+	"EG": {
+		{"Ad Daqahlīyah", "DK"},
+		{"Al Baḩr al Aḩmar", "BA"},
+		{"Al Buḩayrah", "BH"},
+		{"Al Fayyūm", "FYM"},
+		{"Al Gharbīyah", "GH"},
+		{"Al Iskandarīyah", "ALX"},
+		{"Al Ismā'īlīyah", "IS"},
+		{"Al Jīzah", "GZ"},
+		{"Al Minyā", "MN"},
+		{"Al Minūfīyah", "MNF"},
+		{"Al Qalyūbīyah", "KB"},
+		{"Al Qāhirah", "C"},
+		{"Al Uqşur", "LX"},
+		{"Al Wādī al Jadīd", "WAD"},
+		{"As Suways", "SUZ"},
+		{"Ash Sharqīyah", "SHR"},
+		{"Aswān", "ASN"},
+		{"Asyūţ", "AST"},
+		{"Banī Suwayf", "BNS"},
+		{"Būr Sa‘īd", "PTS"},
+		{"Dumyāţ", "DT"},
+		{"Janūb Sīnā'", "JS"},
+		{"Kafr ash Shaykh", "KFS"},
+		{"Maţrūḩ", "MT"},
+		{"Qinā", "KN"},
+		{"Shamāl Sīnā'", "SIN"},
+		{"Sūhāj", "SHG"},
+	},
+	"ER": {
+		{"Al Awsaţ", "MA"},
+		{"Al Janūbī", "DU"},
+		{"Ansabā", "AN"},
+		{"Debubawi K’eyyĭḥ Baḥri", "DK"},
+		{"Gash-Barka", "GB"},
+		{"Semienawi K’eyyĭḥ Baḥri", "SK"},
+	},
+	"ES": {
+		{"Andalucía", "AN"},
+		{"Aragón", "AR"},
+		{"Asturias, Principado de", "AS"},
+		{"Canarias", "CN"},
+		{"Cantabria", "CB"},
+		{"Castilla y León", "CL"},
+		{"Castilla-La Mancha", "CM"},
+		{"Catalunya", "CT"},
+		{"Ceuta", "CE"},
+		{"Euskal Herria", "PV"},
+		{"Extremadura", "EX"},
+		{"Galicia", "GA"},
+		{"Illes Balears", "IB"},
+		{"La Rioja", "RI"},
+		{"Madrid, Comunidad de", "MD"},
+		{"Melilla", "ML"},
+		{"Murcia, Región de", "MC"},
+		{"Nafarroako Foru Komunitatea*", "NC"},
+		{"Valenciana, Comunidad", "VC"},
+		{"Asturias", "AS"},
+		{"Cataluña", "CT"},
+		{"Comunidad Valenciana", "VC"},
+		{"Comunidad de Madrid", "MD"},
+		{"Islas Baleares", "IB"},
+		{"Madrid", "MD"},
+		{"Murcia", "MC"},
+		{"Principado de Asturias", "AS"},
+		{"Región de Murcia", "MC"},
+		{"Valenciana", "VC"},
+		{"A Coruña", "C"},
+		{"Alacant*", "A"},
+		{"Albacete", "AB"},
+		{"Almería", "AL"},
+		{"Araba*", "VI"},
+		{"Badajoz", "BA"},
+		{"Barcelona", "B"},
+		{"Bizkaia", "BI"},
+		{"Burgos", "BU"},
+		{"Castelló*", "CS"},
+		{"Ciudad Real", "CR"},
+		{"Cuenca", "CU"},
+		{"Cáceres", "CC"},
+		{"Cádiz", "CA"},
+		{"Córdoba", "CO"},
+		{"Gipuzkoa", "SS"},
+		{"Girona", "GI"},
+		{"Granada", "GR"},
+		{"Guadalajara", "GU"},
+		{"Huelva", "H"},
+		{"Huesca", "HU"},
+		{"Jaén", "J"},
+		{"Las Palmas", "GC"},
+		{"León", "LE"},
+		{"Lleida", "L"},
+		{"Lugo", "LU"},
+		{"Málaga", "MA"},
+		{"Nafarroa*", "NA"},
+		{"Ourense", "OR"},
+		{"Palencia", "P"},
+		{"Pontevedra", "PO"},
+		{"Salamanca", "SA"},
+		{"Santa Cruz de Tenerife", "TF"},
+		{"Segovia", "SG"},
+		{"Sevilla", "SE"},
+		{"Soria", "SO"},
+		{"Tarragona", "T"},
+		{"Teruel", "TE"},
+		{"Toledo", "TO"},
+		{"Valencia", "V"},
+		{"Valladolid", "VA"},
+		{"Zamora", "ZA"},
+		{"Zaragoza", "Z"},
+		{"Ávila", "AV"},
+		{"Gerona", "GI"},
+		{"La Coruña", "C"},
+		{"Lérida", "L"},
+		{"Orense", "OR"},
+	},
+	"ET": {
+		{"Addis Ababa", "AA"},
+		{"Afar", "AF"},
+		{"Amara", "AM"},
+		{"Benshangul-Gumaz", "BE"},
+		{"Dire Dawa", "DD"},
+		{"Gambela Peoples", "GA"},
+		{"Harari People", "HA"},
+		{"Oromia", "OR"},
+		{"Somali", "SO"},
+		{"Southern Nations, Nationalities and Peoples", "SN"},
+		{"Tigrai", "TI"},
+		{"Nationalities and Peoples Southern Nations", "SN"},
+		{"Southern Nations", "SN"},
+	},
+	"FI": {
+		{"Etelä-Karjala", "02"},
+		{"Etelä-Pohjanmaa", "03"},
+		{"Etelä-Savo", "04"},
+		{"Kainuu", "05"},
+		{"Kanta-Häme", "06"},
+		{"Keski-Pohjanmaa", "07"},
+		{"Keski-Suomi", "08"},
+		{"Kymenlaakso", "09"},
+		{"Lappi", "10"},
+		{"Pirkanmaa", "11"},
+		{"Pohjanmaa", "12"},
+		{"Pohjois-Karjala", "13"},
+		{"Pohjois-Pohjanmaa", "14"},
+		{"Pohjois-Savo", "15"},
+		{"Päijät-Häme", "16"},
+		{"Satakunta", "17"},
+		{"Uusimaa", "18"},
+		{"Varsinais-Suomi", "19"},
+		{"Åland", "01"},
+	},
+	"FJ": {
+		{"Central", "C"},
+		{"Eastern", "E"},
+		{"Northern", "N"},
+		{"Rotuma", "R"},
+		{"Western", "W"},
+		{"Ba", "01"},
+		{"Bua", "02"},
+		{"Cakaudrove", "03"},
+		{"Kadavu", "04"},
+		{"Lau", "05"},
+		{"Lomaiviti", "06"},
+		{"Macuata", "07"},
+		{"Nadroga and Navosa", "08"},
+		{"Naitasiri", "09"},
+		{"Namosi", "10"},
+		{"Ra", "11"},
+		{"Rewa", "12"},
+		{"Serua", "13"},
+		{"Tailevu", "14"},
+	},
+	"FM": {
+		{"Chuuk", "TRK"},
+		{"Kosrae", "KSA"},
+		{"Pohnpei", "PNI"},
+		{"Yap", "YAP"},
+	},
+	"FR": {
+		{"Auvergne-Rhône-Alpes", "ARA"},
+		{"Bourgogne-Franche-Comté", "BFC"},
+		{"Bretagne", "BRE"},
+		{"Centre-Val de Loire", "CVL"},
+		{"Clipperton", "CP"},
+		{"Corse", "20R"},
+		{"Grand-Est", "GES"},
+		{"Guadeloupe", "GP"},
+		{"Guyane (française)", "GF"},
+		{"Hauts-de-France", "HDF"},
+		{"La Réunion", "RE"},
+		{"Martinique", "MQ"},
+		{"Mayotte", "YT"},
+		{"Normandie", "NOR"},
+		{"Nouvelle-Aquitaine", "NAQ"},
+		{"Nouvelle-Calédonie", "NC"},
+		{"Occitanie", "OCC"},
+		{"Pays-de-la-Loire", "PDL"},
+		{"Polynésie française", "PF"},
+		{"Provence-Alpes-Côte-d’Azur", "PAC"},
+		{"Saint-Barthélemy", "BL"},
+		{"Saint-Martin", "MF"},
+		{"Saint-Pierre-et-Miquelon", "PM"},
+		{"Terres australes françaises", "TF"},
+		{"Wallis-et-Futuna", "WF"},
+		{"Île-de-France", "IDF"},
+		{"Guyane", "GF"},
+		{"Ain", "01"},
+		{"Aisne", "02"},
+		{"Allier", "03"},
+		{"Alpes-Maritimes", "06"},
+		{"Alpes-de-Haute-Provence", "04"},
+		{"Ardennes", "08"},
+		{"Ardèche", "07"},
+		{"Ariège", "09"},
+		{"Aube", "10"},
+		{"Aude", "11"},
+		{"Aveyron", "12"},
+		{"Bas-Rhin", "67"},
+		{"Bouches-du-Rhône", "13"},
+		{"Calvados", "14"},
+		{"Cantal", "15"},
+		{"Charente", "16"},
+		{"Charente-Maritime", "17"},
+		{"Cher", "18"},
+		{"Corrèze", "19"},
+		{"Corse-du-Sud", "2A"},
+		{"Creuse", "23"},
+		{"Côte-d'Or", "21"},
+		{"Côtes-d'Armor", "22"},
+		{"Deux-Sèvres", "79"},
+		{"Dordogne", "24"},
+		{"Doubs", "25"},
+		{"Drôme", "26"},
+		{"Essonne", "91"},
+		{"Eure", "27"},
+		{"Eure-et-Loir", "28"},
+		{"Finistère", "29"},
+		{"Gard", "30"},
+		{"Gers", "32"},
+		{"Gironde", "33"},
+		{"Haut-Rhin", "68"},
+		{"Haute-Corse", "2B"},
+		{"Haute-Garonne", "31"},
+		{"Haute-Loire", "43"},
+		{"Haute-Marne", "52"},
+		{"Haute-Savoie", "74"},
+		{"Haute-Saône", "70"},
+		{"Haute-Vienne", "87"},
+		{"Hautes-Alpes", "05"},
+		{"Hautes-Pyrénées", "65"},
+		{"Hauts-de-Seine", "92"},
+		{"Hérault", "34"},
+		{"Ille-et-Vilaine", "35"},
+		{"Indre", "36"},
+		{"Indre-et-Loire", "37"},
+		{"Isère", "38"},
+		{"Jura", "39"},
+		{"Landes", "40"},
+		{"Loir-et-Cher", "41"},
+		{"Loire", "42"},
+		{"Loire-Atlantique", "44"},
+		{"Loiret", "45"},
+		{"Lot", "46"},
+		{"Lot-et-Garonne", "47"},
+		{"Lozère", "48"},
+		{"Maine-et-Loire", "49"},
+		{"Manche", "50"},
+		{"Marne", "51"},
+		{"Mayenne", "53"},
+		{"Meurthe-et-Moselle", "54"},
+		{"Meuse", "55"},
+		{"Morbihan", "56"},
+		{"Moselle", "57"},
+		{"Nièvre", "58"},
+		{"Nord", "59"},
+		{"Oise", "60"},
+		{"Orne", "61"},
+		{"Paris", "75"},
+		{"Pas-de-Calais", "62"},
+		{"Puy-de-Dôme", "63"},
+		{"Pyrénées-Atlantiques", "64"},
+		{"Pyrénées-Orientales", "66"},
+		{"Rhône", "69"},
+		{"Sarthe", "72"},
+		{"Savoie", "73"},
+		{"Saône-et-Loire", "71"},
+		{"Seine-Maritime", "76"},
+		{"Seine-Saint-Denis", "93"},
+		{"Seine-et-Marne", "77"},
+		{"Somme", "80"},
+		{"Tarn", "81"},
+		{"Tarn-et-Garonne", "82"},
+		{"Territoire de Belfort", "90"},
+		{"Val-d'Oise", "95"},
+		{"Val-de-Marne", "94"},
+		{"Var", "83"},
+		{"Vaucluse", "84"},
+		{"Vendée", "85"},
+		{"Vienne", "86"},
+		{"Vosges", "88"},
+		{"Yonne", "89"},
+		{"Yvelines", "78"},
+	},
+	"GA": {
+		{"Estuaire", "1"},
+		{"Haut-Ogooué", "2"},
+		{"Moyen-Ogooué", "3"},
+		{"Ngounié", "4"},
+		{"Nyanga", "5"},
+		{"Ogooué-Ivindo", "6"},
+		{"Ogooué-Lolo", "7"},
+		{"Ogooué-Maritime", "8"},
+		{"Woleu-Ntem", "9"},
+	},
+	"GB": {
+		{"England", "ENG"},
+		{"Northern Ireland", "NIR"},
+		{"Scotland", "SCT"},
+		{"Wales", "WLS"},
+		{"Cymru", "WLS"},
+		{"Aberdeen City", "ABE"},
+		{"Aberdeenshire", "ABD"},
+		{"Angus", "ANS"},
+		{"Antrim and Newtownabbey", "ANN"},
+		{"Ards and North Down", "AND"},
+		{"Argyll and Bute", "AGB"},
+		{"Armagh City, Banbridge and Craigavon", "ABC"},
+		{"Barking and Dagenham", "BDG"},
+		{"Barnet", "BNE"},
+		{"Barnsley", "BNS"},
+		{"Bath and North East Somerset", "BAS"},
+		{"Bedford", "BDF"},
+		{"Belfast City", "BFS"},
+		{"Bexley", "BEX"},
+		{"Birmingham", "BIR"},
+		{"Blackburn with Darwen", "BBD"},
+		{"Blackpool", "BPL"},
+		{"Blaenau Gwent", "BGW"},
+		{"Bolton", "BOL"},
+		{"Bournemouth, Christchurch and Poole", "BCP"},
+		{"Bracknell Forest", "BRC"},
+		{"Bradford", "BRD"},
+		{"Brent", "BEN"},
+		{"Bridgend", "BGE"},
+		{"Brighton and Hove", "BNH"},
+		{"Bristol, City of", "BST"},
+		{"Bromley", "BRY"},
+		{"Buckinghamshire", "BKM"},
+		{"Bury", "BUR"},
+		{"Caerphilly", "CAY"},
+		{"Calderdale", "CLD"},
+		{"Cambridgeshire", "CAM"},
+		{"Camden", "CMD"},
+		{"Cardiff", "CRF"},
+		{"Carmarthenshire", "CMN"},
+		{"Causeway Coast and Glens", "CCG"},
+		{"Central Bedfordshire", "CBF"},
+		{"Ceredigion", "CGN"},
+		{"Cheshire East", "CHE"},
+		{"Cheshire West and Chester", "CHW"},
+		{"Clackmannanshire", "CLK"},
+		{"Conwy", "CWY"},
+		{"Cornwall", "CON"},
+		{"Coventry", "COV"},
+		{"Croydon", "CRY"},
+		{"Cumbria", "CMA"},
+		{"Darlington", "DAL"},
+		{"Denbighshire", "DEN"},
+		{"Derby", "DER"},
+		{"Derbyshire", "DBY"},
+		{"Derry and Strabane", "DRS"},
+		{"Devon", "DEV"},
+		{"Doncaster", "DNC"},
+		{"Dorset", "DOR"},
+		{"Dudley", "DUD"},
+		{"Dumfries and Galloway", "DGY"},
+		{"Dundee City", "DND"},
+		{"Durham, County", "DUR"},
+		{"Ealing", "EAL"},
+		{"East Ayrshire", "EAY"},
+		{"East Dunbartonshire", "EDU"},
+		{"East Lothian", "ELN"},
+		{"East Renfrewshire", "ERW"},
+		{"East Riding of Yorkshire", "ERY"},
+		{"East Sussex", "ESX"},
+		{"Edinburgh, City of", "EDH"},
+		{"Eilean Siar", "ELS"},
+		{"Enfield", "ENF"},
+		{"Essex", "ESS"},
+		{"Falkirk", "FAL"},
+		{"Fermanagh and Omagh", "FMO"},
+		{"Fife", "FIF"},
+		{"Flintshire", "FLN"},
+		{"Gateshead", "GAT"},
+		{"Glasgow City", "GLG"},
+		{"Gloucestershire", "GLS"},
+		{"Greenwich", "GRE"},
+		{"Gwynedd", "GWN"},
+		{"Hackney", "HCK"},
+		{"Halton", "HAL"},
+		{"Hammersmith and Fulham", "HMF"},
+		{"Hampshire", "HAM"},
+		{"Haringey", "HRY"},
+		{"Harrow", "HRW"},
+		{"Hartlepool", "HPL"},
+		{"Havering", "HAV"},
+		{"Herefordshire", "HEF"},
+		{"Hertfordshire", "HRT"},
+		{"Highland", "HLD"},
+		{"Hillingdon", "HIL"},
+		{"Hounslow", "HNS"},
+		{"Inverclyde", "IVC"},
+		{"Isle of Anglesey", "AGY"},
+		{"Isle of Wight", "IOW"},
+		{"Isles of Scilly", "IOS"},
+		{"Islington", "ISL"},
+		{"Kensington and Chelsea", "KEC"},
+		{"Kent", "KEN"},
+		{"Kingston upon Hull", "KHL"},
+		{"Kingston upon Thames", "KTT"},
+		{"Kirklees", "KIR"},
+		{"Knowsley", "KWL"},
+		{"Lambeth", "LBH"},
+		{"Lancashire", "LAN"},
+		{"Leeds", "LDS"},
+		{"Leicester", "LCE"},
+		{"Leicestershire", "LEC"},
+		{"Lewisham", "LEW"},
+		{"Lincolnshire", "LIN"},
+		{"Lisburn and Castlereagh", "LBC"},
+		{"Liverpool", "LIV"},
+		{"London, City of", "LND"},
+		{"Luton", "LUT"},
+		{"Manchester", "MAN"},
+		{"Medway", "MDW"},
+		{"Merthyr Tydfil", "MTY"},
+		{"Merton", "MRT"},
+		{"Mid and East Antrim", "MEA"},
+		{"Mid-Ulster", "MUL"},
+		{"Middlesbrough", "MDB"},
+		{"Midlothian", "MLN"},
+		{"Milton Keynes", "MIK"},
+		{"Monmouthshire", "MON"},
+		{"Moray", "MRY"},
+		{"Neath Port Talbot", "NTL"},
+		{"Newcastle upon Tyne", "NET"},
+		{"Newham", "NWM"},
+		{"Newport", "NWP"},
+		{"Newry, Mourne and Down", "NMD"},
+		{"Norfolk", "NFK"},
+		{"North Ayrshire", "NAY"},
+		{"North East Lincolnshire", "NEL"},
+		{"North Lanarkshire", "NLK"},
+		{"North Lincolnshire", "NLN"},
+		{"North Somerset", "NSM"},
+		{"North Tyneside", "NTY"},
+		{"North Yorkshire", "NYK"},
+		{"Northamptonshire", "NTH"},
+		{"Northumberland", "NBL"},
+		{"Nottingham", "NGM"},
+		{"Nottinghamshire", "NTT"},
+		{"Oldham", "OLD"},
+		{"Orkney Islands", "ORK"},
+		{"Oxfordshire", "OXF"},
+		{"Pembrokeshire", "PEM"},
+		{"Perth and Kinross", "PKN"},
+		{"Peterborough", "PTE"},
+		{"Plymouth", "PLY"},
+		{"Portsmouth", "POR"},
+		{"Powys", "POW"},
+		{"Reading", "RDG"},
+		{"Redbridge", "RDB"},
+		{"Redcar and Cleveland", "RCC"},
+		{"Renfrewshire", "RFW"},
+		{"Rhondda Cynon Taff", "RCT"},
+		{"Richmond upon Thames", "RIC"},
+		{"Rochdale", "RCH"},
+		{"Rotherham", "ROT"},
+		{"Rutland", "RUT"},
+		{"Salford", "SLF"},
+		{"Sandwell", "SAW"},
+		{"Scottish Borders", "SCB"},
+		{"Sefton", "SFT"},
+		{"Sheffield", "SHF"},
+		{"Shetland Islands", "ZET"},
+		{"Shropshire", "SHR"},
+		{"Slough", "SLG"},
+		{"Solihull", "SOL"},
+		{"Somerset", "SOM"},
+		{"South Ayrshire", "SAY"},
+		{"South Gloucestershire", "SGC"},
+		{"South Lanarkshire", "SLK"},
+		{"South Tyneside", "STY"},
+		{"Southampton", "STH"},
+		{"Southend-on-Sea", "SOS"},
+		{"Southwark", "SWK"},
+		{"St. Helens", "SHN"},
+		{"Staffordshire", "STS"},
+		{"Stirling", "STG"},
+		{"Stockport", "SKP"},
+		{"Stockton-on-Tees", "STT"},
+		{"Stoke-on-Trent", "STE"},
+		{"Suffolk", "SFK"},
+		{"Sunderland", "SND"},
+		{"Surrey", "SRY"},
+		{"Sutton", "STN"},
+		{"Swansea", "SWA"},
+		{"Swindon", "SWD"},
+		{"Tameside", "TAM"},
+		{"Telford and Wrekin", "TFW"},
+		{"Thurrock", "THR"},
+		{"Torbay", "TOB"},
+		{"Torfaen", "TOF"},
+		{"Tower Hamlets", "TWH"},
+		{"Trafford", "TRF"},
+		{"Vale of Glamorgan, The", "VGL"},
+		{"Wakefield", "WKF"},
+		{"Walsall", "WLL"},
+		{"Waltham Forest", "WFT"},
+		{"Wandsworth", "WND"},
+		{"Warrington", "WRT"},
+		{"Warwickshire", "WAR"},
+		{"West Berkshire", "WBK"},
+		{"West Dunbartonshire", "WDU"},
+		{"West Lothian", "WLN"},
+		{"West Sussex", "WSX"},
+		{"Westminster", "WSM"},
+		{"Wigan", "WGN"},
+		{"Wiltshire", "WIL"},
+		{"Windsor and Maidenhead", "WNM"},
+		{"Wirral", "WRL"},
+		{"Wokingham", "WOK"},
+		{"Wolverhampton", "WLV"},
+		{"Worcestershire", "WOR"},
+		{"Wrexham", "WRX"},
+		{"York", "YOR"},
+		{"Abertawe", "SWA"},
+		{"Armagh City", "ABC"},
+		{"Banbridge and Craigavon Armagh City", "ABC"},
+		{"Bournemouth", "BCP"},
+		{"Bristol", "BST"},
+		{"Bro Morgannwg", "VGL"},
+		{"Caerdydd", "CRF"},
+		{"Caerffili", "CAY"},
+		{"Casnewydd", "NWP"},
+		{"Castell-nedd Port Talbot", "NTL"},
+		{"Christchurch and Poole Bournemouth", "BCP"},
+		{"City of Bristol", "BST"},
+		{"City of Edinburgh", "EDH"},
+		{"City of London", "LND"},
+		{"County Durham", "DUR"},
+		{"Durham", "DUR"},
+		{"Edinburgh", "EDH"},
+		{"London", "LND"},
+		{"Merthyr Tudful", "MTY"},
+		{"Mourne and Down Newry", "NMD"},
+		{"Newry", "NMD"},
+		{"Pen-y-bont ar Ogwr", "BGE"},
+		{"Rhondda CynonTaf", "RCT"},
+		{"Sir Benfro", "PEM"},
+		{"Sir Ceredigion", "CGN"},
+		{"Sir Ddinbych", "DEN"},
+		{"Sir Fynwy", "MON"},
+		{"Sir Gaerfyrddin", "CMN"},
+		{"Sir Ynys Môn", "AGY"},
+		{"Sir y Fflint", "FLN"},
+		{"The Vale of Glamorgan", "VGL"},
+		{"Tor-faen", "TOF"},
+		{"Vale of Glamorgan", "VGL"},
+		{"Wrecsam", "WRX"},
+	},
+	"GD": {
+		{"Saint Andrew", "01"},
+		{"Saint David", "02"},
+		{"Saint George", "03"},
+		{"Saint John", "04"},
+		{"Saint Mark", "05"},
+		{"Saint Patrick", "06"},
+		{"Southern Grenadine Islands", "10"},
+	},
+	"GE": {
+		{"Abkhazia", "AB"},
+		{"Ajaria", "AJ"},
+		{"Guria", "GU"},
+		{"Imereti", "IM"},
+		{"K'akheti", "KA"},
+		{"Kvemo Kartli", "KK"},
+		{"Mtskheta-Mtianeti", "MM"},
+		{"Rach'a-Lechkhumi-Kvemo Svaneti", "RL"},
+		{"Samegrelo-Zemo Svaneti", "SZ"},
+		{"Samtskhe-Javakheti", "SJ"},
+		{"Shida Kartli", "SK"},
+		{"Tbilisi", "TB"},
+		{"აფხაზეთი", "AB"},
+		{"აჭარა", "AJ"},
+		{"გურია", "GU"},
+		{"თბილისი", "TB"},
+		{"იმერეთი", "IM"},
+		{"მცხეთა-მთიანეთი", "MM"},
+		{"სამეგრელო-ზემო სვანეთი", "SZ"},
+		{"სამცხე-ჯავახეთი", "SJ"},
+		{"ქვემო ქართლი", "KK"},
+		{"შიდა ქართლი", "SK"},
+	},
+	"GH": {
+		{"Ahafo", "AF"},
+		{"Ashanti", "AH"},
+		{"Bono", "BO"},
+		{"Bono East", "BE"},
+		{"Central", "CP"},
+		{"Eastern", "EP"},
+		{"Greater Accra", "AA"},
+		{"North East", "NE"},
+		{"Northern", "NP"},
+		{"Oti", "OT"},
+		{"Savannah", "SV"},
+		{"Upper East", "UE"},
+		{"Upper West", "UW"},
+		{"Volta", "TV"},
+		{"Western", "WP"},
+		{"Western North", "WN"},
+	},
+	"GL": {
+		{"Avannaata Kommunia", "AV"},
+		{"Kommune Kujalleq", "KU"},
+		{"Kommune Qeqertalik", "QT"},
+		{"Kommuneqarfik Sermersooq", "SM"},
+		{"Qeqqata Kommunia", "QE"},
+	},
+	"GM": {
+		{"Banjul", "B"},
+		{"Central River", "M"},
+		{"Lower River", "L"},
+		{"North Bank", "N"},
+		{"Upper River", "U"},
+		{"Western", "W"},
+	},
+	"GN": {
+		{"Boké", "B"},
+		{"Conakry", "C"},
+		{"Faranah", "F"},
+		{"Kankan", "K"},
+		{"Kindia", "D"},
+		{"Labé", "L"},
+		{"Mamou", "M"},
+		{"Nzérékoré", "N"},
+		{"Beyla", "BE"},
+		{"Boffa", "BF"},
+		{"Coyah", "CO"},
+		{"Dabola", "DB"},
+		{"Dalaba", "DL"},
+		{"Dinguiraye", "DI"},
+		{"Dubréka", "DU"},
+		{"Forécariah", "FO"},
+		{"Fria", "FR"},
+		{"Gaoual", "GA"},
+		{"Guékédou", "GU"},
+		{"Kissidougou", "KS"},
+		{"Koubia", "KB"},
+		{"Koundara", "KN"},
+		{"Kouroussa", "KO"},
+		{"Kérouané", "KE"},
+		{"Lola", "LO"},
+		{"Lélouma", "LE"},
+		{"Macenta", "MC"},
+		{"Mali", "ML"},
+		{"Mandiana", "MD"},
+		{"Pita", "PI"},
+		{"Siguiri", "SI"},
+		{"Tougué", "TO"},
+		{"Télimélé", "TE"},
+		{"Yomou", "YO"},
+	},
+	"GQ": {
+		{"Região Continental", "C"},
+		{"Região Insular", "I"},
+		{"Annobon", "AN"},
+		{"Bioko Nord", "BN"},
+		{"Bioko Sud", "BS"},
+		{"Centro Sud", "CS"},
+		{"Djibloho", "DJ"},
+		{"Kié-Ntem", "KN"},
+		{"Litoral", "LI"},
+		{"Wele-Nzas", "WN"},
+	},
+	"GR": {
+		{"Anatolikí Makedonía kai Thráki", "A"},
+		{"Attikí", "I"},
+		{"Dytikí Elláda", "G"},
+		{"Dytikí Makedonía", "C"},
+		{"Ionía Nísia", "F"},
+		{"Kentrikí Makedonía", "B"},
+		{"Kríti", "M"},
+		{"Nótio Aigaío", "L"},
+		{"Pelopónnisos", "J"},
+		{"Stereá Elláda", "H"},
+		{"Thessalía", "E"},
+		{"Vóreio Aigaío", "K"},
+		{"Ágion Óros", "69"},
+		{"Ípeiros", "D"},
+	},
+	"GT": {
+		{"Alta Verapaz", "AV"},
+		{"Baja Verapaz", "BV"},
+		{"Chimaltenango", "CM"},
+		{"Chiquimula", "CQ"},
+		{"El Progreso", "PR"},
+		{"Escuintla", "ES"},
+		{"Guatemala", "GU"},
+		{"Huehuetenango", "HU"},
+		{"Izabal", "IZ"},
+		{"Jalapa", "JA"},
+		{"Jutiapa", "JU"},
+		{"Petén", "PE"},
+		{"Quetzaltenango", "QZ"},
+		{"Quiché", "QC"},
+		{"Retalhuleu", "RE"},
+		{"Sacatepéquez", "SA"},
+		{"San Marcos", "SM"},
+		{"Santa Rosa", "SR"},
+		{"Sololá", "SO"},
+		{"Suchitepéquez", "SU"},
+		{"Totonicapán", "TO"},
+		{"Zacapa", "ZA"},
+	},
+	"GW": {
+		{"Bissau", "BS"},
+		{"Leste", "L"},
+		{"Norte", "N"},
+		{"Sul", "S"},
+		{"Bafatá", "BA"},
+		{"Biombo", "BM"},
+		{"Bolama / Bijagós", "BL"},
+		{"Cacheu", "CA"},
+		{"Gabú", "GA"},
+		{"Oio", "OI"},
+		{"Quinara", "QU"},
+		{"Tombali", "TO"},
+		{"Bijagós", "BL"},
+		{"Bolama", "BL"},
+	},
+	"GY": {
+		{"Barima-Waini", "BA"},
+		{"Cuyuni-Mazaruni", "CU"},
+		{"Demerara-Mahaica", "DE"},
+		{"East Berbice-Corentyne", "EB"},
+		{"Essequibo Islands-West Demerara", "ES"},
+		{"Mahaica-Berbice", "MA"},
+		{"Pomeroon-Supenaam", "PM"},
+		{"Potaro-Siparuni", "PT"},
+		{"Upper Demerara-Berbice", "UD"},
+		{"Upper Takutu-Upper Essequibo", "UT"},
+	},
+	"HN": {
+		{"Atlántida", "AT"},
+		{"Choluteca", "CH"},
+		{"Colón", "CL"},
+		{"Comayagua", "CM"},
+		{"Copán", "CP"},
+		{"Cortés", "CR"},
+		{"El Paraíso", "EP"},
+		{"Francisco Morazán", "FM"},
+		{"Gracias a Dios", "GD"},
+		{"Intibucá", "IN"},
+		{"Islas de la Bahía", "IB"},
+		{"La Paz", "LP"},
+		{"Lempira", "LE"},
+		{"Ocotepeque", "OC"},
+		{"Olancho", "OL"},
+		{"Santa Bárbara", "SB"},
+		{"Valle", "VA"},
+		{"Yoro", "YO"},
+	},
+	"HR": {
+		{"Bjelovarsko-bilogorska županija", "07"},
+		{"Brodsko-posavska županija", "12"},
+		{"Dubrovačko-neretvanska županija", "19"},
+		{"Grad Zagreb", "21"},
+		{"Istarska županija", "18"},
+		{"Karlovačka županija", "04"},
+		{"Koprivničko-križevačka županija", "06"},
+		{"Krapinsko-zagorska županija", "02"},
+		{"Ličko-senjska županija", "09"},
+		{"Međimurska županija", "20"},
+		{"Osječko-baranjska županija", "14"},
+		{"Požeško-slavonska županija", "11"},
+		{"Primorsko-goranska županija", "08"},
+		{"Sisačko-moslavačka županija", "03"},
+		{"Splitsko-dalmatinska županija", "17"},
+		{"Varaždinska županija", "05"},
+		{"Virovitičko-podravska županija", "10"},
+		{"Vukovarsko-srijemska županija", "16"},
+		{"Zadarska županija", "13"},
+		{"Zagrebačka županija", "01"},
+		{"Šibensko-kninska županija", "15"},
+	},
+	"HT": {
+		{"Artibonite", "AR"},
+		{"Centre", "CE"},
+		{"Grandans", "GA"},
+		{"Lwès", "OU"},
+		{"Nip", "NI"},
+		{"Nord", "ND"},
+		{"Nord-Est", "NE"},
+		{"Nord-Ouest", "NO"},
+		{"Sid", "SD"},
+		{"Sidès", "SE"},
+	},
+	"HU": {
+		{"Baranya", "BA"},
+		{"Borsod-Abaúj-Zemplén", "BZ"},
+		{"Budapest", "BU"},
+		{"Bács-Kiskun", "BK"},
+		{"Békés", "BE"},
+		{"Békéscsaba", "BC"},
+		{"Csongrád", "CS"},
+		{"Debrecen", "DE"},
+		{"Dunaújváros", "DU"},
+		{"Eger", "EG"},
+		{"Fejér", "FE"},
+		{"Győr", "GY"},
+		{"Győr-Moson-Sopron", "GS"},
+		{"Hajdú-Bihar", "HB"},
+		{"Heves", "HE"},
+		{"Hódmezővásárhely", "HV"},
+		{"Jász-Nagykun-Szolnok", "JN"},
+		{"Kaposvár", "KV"},
+		{"Kecskemét", "KM"},
+		{"Komárom-Esztergom", "KE"},
+		{"Miskolc", "MI"},
+		{"Nagykanizsa", "NK"},
+		{"Nyíregyháza", "NY"},
+		{"Nógrád", "NO"},
+		{"Pest", "PE"},
+		{"Pécs", "PS"},
+		{"Salgótarján", "ST"},
+		{"Somogy", "SO"},
+		{"Sopron", "SN"},
+		{"Szabolcs-Szatmár-Bereg", "SZ"},
+		{"Szeged", "SD"},
+		{"Szekszárd", "SS"},
+		{"Szolnok", "SK"},
+		{"Szombathely", "SH"},
+		{"Székesfehérvár", "SF"},
+		{"Tatabánya", "TB"},
+		{"Tolna", "TO"},
+		{"Vas", "VA"},
+		{"Veszprém", "VE"},
+		{"Zala", "ZA"},
+		{"Zalaegerszeg", "ZE"},
+		{"Érd", "ER"},
+	},
+	"ID": {
+		{"Jawa", "JW"},
+		{"Kalimantan", "KA"},
+		{"Maluku", "ML"},
+		{"Nusa Tenggara", "NU"},
+		{"Papua", "PP"},
+		{"Sulawesi", "SL"},
+		{"Sumatera", "SM"},
+		{"Aceh", "AC"},
+		{"Bali", "BA"},
+		{"Banten", "BT"},
+		{"Bengkulu", "BE"},
+		{"Gorontalo", "GO"},
+		{"Jakarta Raya", "JK"},
+		{"Jambi", "JA"},
+		{"Jawa Barat", "JB"},
+		{"Jawa Tengah", "JT"},
+		{"Jawa Timur", "JI"},
+		{"Kalimantan Barat", "KB"},
+		{"Kalimantan Selatan", "KS"},
+		{"Kalimantan Tengah", "KT"},
+		{"Kalimantan Timur", "KI"},
+		{"Kalimantan Utara", "KU"},
+		{"Kepulauan Bangka Belitung", "BB"},
+		{"Kepulauan Riau", "KR"},
+		{"Lampung", "LA"},
+		{"Maluku Utara", "MU"},
+		{"Nusa Tenggara Barat", "NB"},
+		{"Nusa Tenggara Timur", "NT"},
+		{"Papua Barat", "PB"},
+		{"Riau", "RI"},
+		{"Sulawesi Barat", "SR"},
+		{"Sulawesi Selatan", "SN"},
+		{"Sulawesi Tengah", "ST"},
+		{"Sulawesi Tenggara", "SG"},
+		{"Sulawesi Utara", "SA"},
+		{"Sumatera Barat", "SB"},
+		{"Sumatera Selatan", "SS"},
+		{"Sumatera Utara", "SU"},
+		{"Yogyakarta", "YO"},
+	},
+	"IE": {
+		{"Connaught", "C"},
+		{"Leinster", "L"},
+		{"Munster", "M"},
+		{"Ulster", "U"},
+		{"Carlow", "CW"},
+		{"Cavan", "CN"},
+		{"Clare", "CE"},
+		{"Cork", "CO"},
+		{"Donegal", "DL"},
+		{"Dublin", "D"},
+		{"Galway", "G"},
+		{"Kerry", "KY"},
+		{"Kildare", "KE"},
+		{"Kilkenny", "KK"},
+		{"Laois", "LS"},
+		{"Leitrim", "LM"},
+		{"Limerick", "LK"},
+		{"Longford", "LD"},
+		{"Louth", "LH"},
+		{"Mayo", "MO"},
+		{"Meath", "MH"},
+		{"Monaghan", "MN"},
+		{"Offaly", "OY"},
+		{"Roscommon", "RN"},
+		{"Sligo", "SO"},
+		{"Tipperary", "TA"},
+		{"Waterford", "WD"},
+		{"Westmeath", "WH"},
+		{"Wexford", "WX"},
+		{"Wicklow", "WW"},
+	},
+	"IL": {
+		{"Al Awsaţ", "M"},
+		{"Al Janūbī", "D"},
+		{"Al Quds", "JM"},
+		{"Ash Shamālī", "Z"},
+		{"H̱efa", "HA"},
+		{"Tall Abīb", "TA"},
+		{"הדרום", "D"},
+		{"המרכז", "M"},
+		{"הצפון", "Z"},
+		{"חיפה", "HA"},
+		{"ירושלים", "JM"},
+		{"תל אביב", "TA"},
+	},
+	"IN": {
+		{"Andaman and Nicobar Islands", "AN"},
+		{"Andhra Pradesh", "AP"},
+		{"Arunāchal Pradesh", "AR"},
+		{"Assam", "AS"},
+		{"Bihār", "BR"},
+		{"Chandīgarh", "CH"},
+		{"Chhattīsgarh", "CT"},
+		{"Delhi", "DL"},
+		{"Dādra and Nagar Haveli and Damān and Diu", "DH"},
+		{"Goa", "GA"},
+		{"Gujarāt", "GJ"},
+		{"Haryāna", "HR"},
+		{"Himāchal Pradesh", "HP"},
+		{"Jammu and Kashmīr", "JK"},
+		{"Jhārkhand", "JH"},
+		{"Karnātaka", "KA"},
+		{"Kerala", "KL"},
+		{"Ladākh", "LA"},
+		{"Lakshadweep", "LD"},
+		{"Madhya Pradesh", "MP"},
+		{"Mahārāshtra", "MH"},
+		{"Manipur", "MN"},
+		{"Meghālaya", "ML"},
+		{"Mizoram", "MZ"},
+		{"Nāgāland", "NL"},
+		{"Odisha", "OR"},
+		{"Puducherry", "PY"},
+		{"Punjab", "PB"},
+		{"Rājasthān", "RJ"},
+		{"Sikkim", "SK"},
+		{"Tamil Nādu", "TN"},
+		{"Telangāna", "TG"},
+		{"Tripura", "TR"},
+		{"Uttar Pradesh", "UP"},
+		{"Uttarākhand", "UT"},
+		{"West Bengal", "WB"},
+	},
+	"IQ": {
+		{"Al Anbār", "AN"},
+		{"Al Başrah", "BA"},
+		{"Al Muthanná", "MU"},
+		{"Al Qādisīyah", "QA"},
+		{"An Najaf", "NA"},
+		{"Arbīl", "AR"},
+		{"As Sulaymānīyah", "SU"},
+		{"Baghdād", "BG"},
+		{"Bābil", "BB"},
+		{"Dahūk", "DA"},
+		{"Dhī Qār", "DQ"},
+		{"Diyālá", "DI"},
+		{"Karbalā’", "KA"},
+		{"Kirkūk", "KI"},
+		{"Maysān", "MA"},
+		{"Nīnawá", "NI"},
+		{"Wāsiţ", "WA"},
+		{"Şalāḩ ad Dīn", "SD"},
+	},
+	"IR": {
+		{"Alborz", "30"},
+		{"Ardabīl", "24"},
+		{"Būshehr", "18"},
+		{"Chahār Maḩāl va Bakhtīārī", "14"},
+		{"Eşfahān", "10"},
+		{"Fārs", "07"},
+		{"Golestān", "27"},
+		{"Gīlān", "01"},
+		{"Hamadān", "13"},
+		{"Hormozgān", "22"},
+		{"Kermān", "08"},
+		{"Kermānshāh", "05"},
+		{"Khorāsān-e Jonūbī", "29"},
+		{"Khorāsān-e Raẕavī", "09"},
+		{"Khorāsān-e Shomālī", "28"},
+		{"Khūzestān", "06"},
+		{"Kohgīlūyeh va Bowyer Aḩmad", "17"},
+		{"Kordestān", "12"},
+		{"Lorestān", "15"},
+		{"Markazī", "00"},
+		{"Māzandarān", "02"},
+		{"Qazvīn", "26"},
+		{"Qom", "25"},
+		{"Semnān", "20"},
+		{"Sīstān va Balūchestān", "11"},
+		{"Tehrān", "23"},
+		{"Yazd", "21"},
+		{"Zanjān", "19"},
+		{"Āz̄ārbāyjān-e Ghārbī", "04"},
+		{"Āz̄ārbāyjān-e Shārqī", "03"},
+		{"Īlām", "16"},
+	},
+	"IS": {
+		{"Austurland", "7"},
+		{"Höfuðborgarsvæði", "1"},
+		{"Norðurland eystra", "6"},
+		{"Norðurland vestra", "5"},
+		{"Suðurland", "8"},
+		{"Suðurnes", "2"},
+		{"Vestfirðir", "4"},
+		{"Vesturland", "3"},
+		{"Akrahreppur", "AKH"},
+		{"Akraneskaupstaður", "AKN"},
+		{"Akureyrarbær", "AKU"},
+		{"Bláskógabyggð", "BLA"},
+		{"Blönduósbær", "BLO"},
+		{"Bolungarvíkurkaupstaður", "BOL"},
+		{"Borgarbyggð", "BOG"},
+		{"Borgarfjarðarhreppur", "BFJ"},
+		{"Dalabyggð", "DAB"},
+		{"Dalvíkurbyggð", "DAV"},
+		{"Djúpavogshreppur", "DJU"},
+		{"Eyja- og Miklaholtshreppur", "EOM"},
+		{"Eyjafjarðarsveit", "EYF"},
+		{"Fjallabyggð", "FJL"},
+		{"Fjarðabyggð", "FJD"},
+		{"Fljótsdalshreppur", "FLR"},
+		{"Fljótsdalshérað", "FLD"},
+		{"Flóahreppur", "FLA"},
+		{"Garðabær", "GAR"},
+		{"Grindavíkurbær", "GRN"},
+		{"Grundarfjarðarbær", "GRU"},
+		{"Grímsnes- og Grafningshreppur", "GOG"},
+		{"Grýtubakkahreppur", "GRY"},
+		{"Hafnarfjarðarkaupstaður", "HAF"},
+		{"Helgafellssveit", "HEL"},
+		{"Hrunamannahreppur", "HRU"},
+		{"Hvalfjarðarsveit", "HVA"},
+		{"Hveragerðisbær", "HVE"},
+		{"Hörgársveit", "HRG"},
+		{"Húnavatnshreppur", "HUT"},
+		{"Húnaþing vestra", "HUV"},
+		{"Kaldrananeshreppur", "KAL"},
+		{"Kjósarhreppur", "KJO"},
+		{"Kópavogsbær", "KOP"},
+		{"Langanesbyggð", "LAN"},
+		{"Mosfellsbær", "MOS"},
+		{"Mýrdalshreppur", "MYR"},
+		{"Norðurþing", "NOR"},
+		{"Rangárþing eystra", "RGE"},
+		{"Rangárþing ytra", "RGY"},
+		{"Reykhólahreppur", "RHH"},
+		{"Reykjanesbær", "RKN"},
+		{"Reykjavíkurborg", "RKV"},
+		{"Seltjarnarnesbær", "SEL"},
+		{"Seyðisfjarðarkaupstaður", "SEY"},
+		{"Skaftárhreppur", "SKF"},
+		{"Skagabyggð", "SKG"},
+		{"Skeiða- og Gnúpverjahreppur", "SOG"},
+		{"Skorradalshreppur", "SKO"},
+		{"Skútustaðahreppur", "SKU"},
+		{"Snæfellsbær", "SNF"},
+		{"Strandabyggð", "STR"},
+		{"Stykkishólmsbær", "STY"},
+		{"Suðurnesjabær", "SDN"},
+		{"Svalbarðshreppur", "SBH"},
+		{"Svalbarðsstrandarhreppur", "SBT"},
+		{"Sveitarfélagið Hornafjörður", "SHF"},
+		{"Sveitarfélagið Skagafjörður", "SSF"},
+		{"Sveitarfélagið Skagaströnd", "SSS"},
+		{"Sveitarfélagið Vogar", "SVG"},
+		{"Sveitarfélagið Árborg", "SFA"},
+		{"Sveitarfélagið Ölfus", "SOL"},
+		{"Súðavíkurhreppur", "SDV"},
+		{"Tjörneshreppur", "TJO"},
+		{"Tálknafjarðarhreppur", "TAL"},
+		{"Vestmannaeyjabær", "VEM"},
+		{"Vesturbyggð", "VER"},
+		{"Vopnafjarðarhreppur", "VOP"},
+		{"Árneshreppur", "ARN"},
+		{"Ásahreppur", "ASA"},
+		{"Ísafjarðarbær", "ISA"},
+		{"Þingeyjarsveit", "THG"},
+	},
+	"IT": {
+		{"Abruzzo", "65"},
+		{"Basilicata", "77"},
+		{"Calabria", "78"},
+		{"Campania", "72"},
+		{"Emilia-Romagna", "45"},
+		{"Friuli Venezia Giulia", "36"},
+		{"Lazio", "62"},
+		{"Liguria", "42"},
+		{"Lombardia", "25"},
+		{"Marche", "57"},
+		{"Molise", "67"},
+		{"Piemonte", "21"},
+		{"Puglia", "75"},
+		{"Sardegna", "88"},
+		{"Sicilia", "82"},
+		{"Toscana", "52"},
+		{"Trentino-Alto Adige", "32"},
+		{"Umbria", "55"},
+		{"Val d'Aoste", "23"},
+		{"Veneto", "34"},
+		{"Agrigento", "AG"},
+		{"Alessandria", "AL"},
+		{"Ancona", "AN"},
+		{"Arezzo", "AR"},
+		{"Ascoli Piceno", "AP"},
+		{"Asti", "AT"},
+		{"Avellino", "AV"},
+		{"Bari", "BA"},
+		{"Barletta-Andria-Trani", "BT"},
+		{"Belluno", "BL"},
+		{"Benevento", "BN"},
+		{"Bergamo", "BG"},
+		{"Biella", "BI"},
+		{"Bologna", "BO"},
+		{"Bolzano", "BZ"},
+		{"Brescia", "BS"},
+		{"Brindisi", "BR"},
+		{"Cagliari", "CA"},
+		{"Caltanissetta", "CL"},
+		{"Campobasso", "CB"},
+		{"Caserta", "CE"},
+		{"Catania", "CT"},
+		{"Catanzaro", "CZ"},
+		{"Chieti", "CH"},
+		{"Como", "CO"},
+		{"Cosenza", "CS"},
+		{"Cremona", "CR"},
+		{"Crotone", "KR"},
+		{"Cuneo", "CN"},
+		{"Enna", "EN"},
+		{"Fermo", "FM"},
+		{"Ferrara", "FE"},
+		{"Firenze", "FI"},
+		{"Foggia", "FG"},
+		{"Forlì-Cesena", "FC"},
+		{"Frosinone", "FR"},
+		{"Genova", "GE"},
+		{"Gorizia", "GO"},
+		{"Grosseto", "GR"},
+		{"Imperia", "IM"},
+		{"Isernia", "IS"},
+		{"L'Aquila", "AQ"},
+		{"La Spezia", "SP"},
+		{"Latina", "LT"},
+		{"Lecce", "LE"},
+		{"Lecco", "LC"},
+		{"Livorno", "LI"},
+		{"Lodi", "LO"},
+		{"Lucca", "LU"},
+		{"Macerata", "MC"},
+		{"Mantova", "MN"},
+		{"Massa-Carrara", "MS"},
+		{"Matera", "MT"},
+		{"Messina", "ME"},
+		{"Milano", "MI"},
+		{"Modena", "MO"},
+		{"Monza e Brianza", "MB"},
+		{"Napoli", "NA"},
+		{"Novara", "NO"},
+		{"Nuoro", "NU"},
+		{"Oristano", "OR"},
+		{"Padova", "PD"},
+		{"Palermo", "PA"},
+		{"Parma", "PR"},
+		{"Pavia", "PV"},
+		{"Perugia", "PG"},
+		{"Pesaro e Urbino", "PU"},
+		{"Pescara", "PE"},
+		{"Piacenza", "PC"},
+		{"Pisa", "PI"},
+		{"Pistoia", "PT"},
+		{"Pordenone", "PN"},
+		{"Potenza", "PZ"},
+		{"Prato", "PO"},
+		{"Ragusa", "RG"},
+		{"Ravenna", "RA"},
+		{"Reggio Calabria", "RC"},
+		{"Reggio Emilia", "RE"},
+		{"Rieti", "RI"},
+		{"Rimini", "RN"},
+		{"Roma", "RM"},
+		{"Rovigo", "RO"},
+		{"Salerno", "SA"},
+		{"Sassari", "SS"},
+		{"Savona", "SV"},
+		{"Siena", "SI"},
+		{"Siracusa", "SR"},
+		{"Sondrio", "SO"},
+		{"Sud Sardegna", "SU"},
+		{"Taranto", "TA"},
+		{"Teramo", "TE"},
+		{"Terni", "TR"},
+		{"Torino", "TO"},
+		{"Trapani", "TP"},
+		{"Trento", "TN"},
+		{"Treviso", "TV"},
+		{"Trieste", "TS"},
+		{"Udine", "UD"},
+		{"Varese", "VA"},
+		{"Venezia", "VE"},
+		{"Verbano-Cusio-Ossola", "VB"},
+		{"Vercelli", "VC"},
+		{"Verona", "VR"},
+		{"Vibo Valentia", "VV"},
+		{"Vicenza", "VI"},
+		{"Viterbo", "VT"},
+	},
+	"JM": {
+		{"Clarendon", "13"},
+		{"Hanover", "09"},
+		{"Kingston", "01"},
+		{"Manchester", "12"},
+		{"Portland", "04"},
+		{"Saint Andrew", "02"},
+		{"Saint Ann", "06"},
+		{"Saint Catherine", "14"},
+		{"Saint Elizabeth", "11"},
+		{"Saint James", "08"},
+		{"Saint Mary", "05"},
+		{"Saint Thomas", "03"},
+		{"Trelawny", "07"},
+		{"Westmoreland", "10"},
+	},
+	"JO": {
+		{"Al Balqā’", "BA"},
+		{"Al Karak", "KA"},
+		{"Al Mafraq", "MA"},
+		{"Al ‘Aqabah", "AQ"},
+		{"Al ‘A̅şimah", "AM"},
+		{"Az Zarqā’", "AZ"},
+		{"Aţ Ţafīlah", "AT"},
+		{"Irbid", "IR"},
+		{"Jarash", "JA"},
+		{"Ma‘ān", "MN"},
+		{"Mādabā", "MD"},
+		{"‘Ajlūn", "AJ"},
+	},
+	"JP": {
+		{"Aichi", "23"},
+		{"Akita", "05"},
+		{"Aomori", "02"},
+		{"Chiba", "12"},
+		{"Ehime", "38"},
+		{"Fukui", "18"},
+		{"Fukuoka", "40"},
+		{"Fukushima", "07"},
+		{"Gifu", "21"},
+		{"Gunma", "10"},
+		{"Hiroshima", "34"},
+		{"Hokkaido", "01"},
+		{"Hyogo", "28"},
+		{"Ibaraki", "08"},
+		{"Ishikawa", "17"},
+		{"Iwate", "03"},
+		{"Kagawa", "37"},
+		{"Kagoshima", "46"},
+		{"Kanagawa", "14"},
+		{"Kochi", "39"},
+		{"Kumamoto", "43"},
+		{"Kyoto", "26"},
+		{"Mie", "24"},
+		{"Miyagi", "04"},
+		{"Miyazaki", "45"},
+		{"Nagano", "20"},
+		{"Nagasaki", "42"},
+		{"Nara", "29"},
+		{"Niigata", "15"},
+		{"Oita", "44"},
+		{"Okayama", "33"},
+		{"Okinawa", "47"},
+		{"Osaka", "27"},
+		{"Saga", "41"},
+		{"Saitama", "11"},
+		{"Shiga", "25"},
+		{"Shimane", "32"},
+		{"Shizuoka", "22"},
+		{"Tochigi", "09"},
+		{"Tokushima", "36"},
+		{"Tokyo", "13"},
+		{"Tottori", "31"},
+		{"Toyama", "16"},
+		{"Wakayama", "30"},
+		{"Yamagata", "06"},
+		{"Yamaguchi", "35"},
+		{"Yamanashi", "19"},
+		{"三重", "24"},
+		{"京都", "26"},
+		{"佐賀", "41"},
+		{"兵庫", "28"},
+		{"北海道", "01"},
+		{"千葉", "12"},
+		{"和歌山", "30"},
+		{"埼玉", "11"},
+		{"大分", "44"},
+		{"大阪", "27"},
+		{"奈良", "29"},
+		{"宮城", "04"},
+		{"宮崎", "45"},
+		{"富山", "16"},
+		{"山口", "35"},
+		{"山形", "06"},
+		{"山梨", "19"},
+		{"岐阜", "21"},
+		{"岡山", "33"},
+		{"岩手", "03"},
+		{"島根", "32"},
+		{"広島", "34"},
+		{"徳島", "36"},
+		{"愛媛", "38"},
+		{"愛知", "23"},
+		{"新潟", "15"},
+		{"東京", "13"},
+		{"栃木", "09"},
+		{"沖縄", "47"},
+		{"滋賀", "25"},
+		{"熊本", "43"},
+		{"石川", "17"},
+		{"神奈川", "14"},
+		{"福井", "18"},
+		{"福岡", "40"},
+		{"福島", "07"},
+		{"秋田", "05"},
+		{"群馬", "10"},
+		{"茨城", "08"},
+		{"長崎", "42"},
+		{"長野", "20"},
+		{"青森", "02"},
+		{"静岡", "22"},
+		{"香川", "37"},
+		{"高知", "39"},
+		{"鳥取", "31"},
+		{"鹿児島", "46"},
+	},
+	"KE": {
+		{"Baringo", "01"},
+		{"Bomet", "02"},
+		{"Bungoma", "03"},
+		{"Busia", "04"},
+		{"Elgeyo/Marakwet", "05"},
+		{"Embu", "06"},
+		{"Garissa", "07"},
+		{"Homa Bay", "08"},
+		{"Isiolo", "09"},
+		{"Kajiado", "10"},
+		{"Kakamega", "11"},
+		{"Kericho", "12"},
+		{"Kiambu", "13"},
+		{"Kilifi", "14"},
+		{"Kirinyaga", "15"},
+		{"Kisii", "16"},
+		{"Kisumu", "17"},
+		{"Kitui", "18"},
+		{"Kwale", "19"},
+		{"Laikipia", "20"},
+		{"Lamu", "21"},
+		{"Machakos", "22"},
+		{"Makueni", "23"},
+		{"Mandera", "24"},
+		{"Marsabit", "25"},
+		{"Meru", "26"},
+		{"Migori", "27"},
+		{"Mombasa", "28"},
+		{"Murang'a", "29"},
+		{"Nairobi City", "30"},
+		{"Nakuru", "31"},
+		{"Nandi", "32"},
+		{"Narok", "33"},
+		{"Nyamira", "34"},
+		{"Nyandarua", "35"},
+		{"Nyeri", "36"},
+		{"Samburu", "37"},
+		{"Siaya", "38"},
+		{"Taita/Taveta", "39"},
+		{"Tana River", "40"},
+		{"Tharaka-Nithi", "41"},
+		{"Trans Nzoia", "42"},
+		{"Turkana", "43"},
+		{"Uasin Gishu", "44"},
+		{"Vihiga", "45"},
+		{"Wajir", "46"},
+		{"West Pokot", "47"},
+	},
+	"KG": {
+		{"Batken", "B"},
+		{"Bishkek Shaary", "GB"},
+		{"Chuyskaya oblast'", "C"},
+		{"Dzhalal-Abadskaya oblast'", "J"},
+		{"Gorod Osh", "GO"},
+		{"Issyk-Kul'skaja oblast'", "Y"},
+		{"Naryn", "N"},
+		{"Osh", "O"},
+		{"Talas", "T"},
+	},
+	"KH": {
+		{"Baat Dambang", "2"},
+		{"Banteay Mean Choăy", "1"},
+		{"Kaeb", "23"},
+		{"Kampong Chaam", "3"},
+		{"Kampong Chhnang", "4"},
+		{"Kampong Spueu", "5"},
+		{"Kampong Thum", "6"},
+		{"Kampot", "7"},
+		{"Kandaal", "8"},
+		{"Kaoh Kong", "9"},
+		{"Kracheh", "10"},
+		{"Mondol Kiri", "11"},
+		{"Otdar Mean Chey", "22"},
+		{"Pailin", "24"},
+		{"Phnom Penh", "12"},
+		{"Pousaat", "15"},
+		{"Preah Sihanouk", "18"},
+		{"Preah Vihear", "13"},
+		{"Prey Veaeng", "14"},
+		{"Rotanak Kiri", "16"},
+		{"Siem Reab", "17"},
+		{"Stoĕng Trêng", "19"},
+		{"Svaay Rieng", "20"},
+		{"Taakaev", "21"},
+		{"Tbong Khmum", "25"},
+	},
+	"KI": {
+		{"Gilbert Islands", "G"},
+		{"Line Islands", "L"},
+		{"Phoenix Islands", "P"},
+	},
+	"KM": {
+		{"Andjazîdja", "G"},
+		{"Andjouân", "A"},
+		{"Mohéli", "M"},
+	},
+	"KN": {
+		{"Nevis", "N"},
+		{"Saint Kitts", "K"},
+		{"Christ Church Nichola Town", "01"},
+		{"Saint Anne Sandy Point", "02"},
+		{"Saint George Basseterre", "03"},
+		{"Saint George Gingerland", "04"},
+		{"Saint James Windward", "05"},
+		{"Saint John Capisterre", "06"},
+		{"Saint John Figtree", "07"},
+		{"Saint Mary Cayon", "08"},
+		{"Saint Paul Capisterre", "09"},
+		{"Saint Paul Charlestown", "10"},
+		{"Saint Peter Basseterre", "11"},
+		{"Saint Thomas Lowland", "12"},
+		{"Saint Thomas Middle Island", "13"},
+		{"Trinity Palmetto Point", "15"},
+	},
+	"KP": {
+		{"Chagang-do", "04"},
+		{"Hamgyǒng-bukto", "09"},
+		{"Hamgyǒng-namdo", "08"},
+		{"Hwanghae-bukto", "06"},
+		{"Hwanghae-namdo", "05"},
+		{"Kangweonto", "07"},
+		{"Nampho", "14"},
+		{"P'yǒngan-bukto", "03"},
+		{"P'yǒngan-namdo", "02"},
+		{"P'yǒngyang", "01"},
+		{"Raseon", "13"},
+		{"Ryanggang-do", "10"},
+	},
+	"KR": {
+		{"Busan-gwangyeoksi", "26"},
+		{"Chungcheongbuk-do", "43"},
+		{"Chungcheongnam-do", "44"},
+		{"Daegu-gwangyeoksi", "27"},
+		{"Daejeon-gwangyeoksi", "30"},
+		{"Gangwon-do", "42"},
+		{"Gwangju-gwangyeoksi", "29"},
+		{"Gyeonggi-do", "41"},
+		{"Gyeongsangbuk-do", "47"},
+		{"Gyeongsangnam-do", "48"},
+		{"Incheon-gwangyeoksi", "28"},
+		{"Jeju-teukbyeoljachido", "49"},
+		{"Jeollabuk-do", "45"},
+		{"Jeollanam-do", "46"},
+		{"Sejong", "50"},
+		{"Seoul-teukbyeolsi", "11"},
+		{"Ulsan-gwangyeoksi", "31"},
+	},
+	"KW": {
+		{"Al Aḩmadī", "AH"},
+		{"Al Farwānīyah", "FA"},
+		{"Al Jahrā’", "JA"},
+		{"Al ‘Āşimah", "KU"},
+		{"Mubārak al Kabīr", "MU"},
+		{"Ḩawallī", "HA"},
+	},
+	"KZ": {
+		{"Akmolinskaja oblast'", "AKM"},
+		{"Aktjubinskaja oblast'", "AKT"},
+		{"Almatinskaja oblast'", "ALM"},
+		{"Almaty", "ALA"},
+		{"Atyrauskaja oblast'", "ATY"},
+		{"Batys Qazaqstan oblysy", "ZAP"},
+		{"Karagandinskaja oblast'", "KAR"},
+		{"Kostanajskaja oblast'", "KUS"},
+		{"Kyzylordinskaja oblast'", "KZY"},
+		{"Mangghystaū oblysy", "MAN"},
+		{"Nur-Sultan", "AST"},
+		{"Pavlodar oblysy", "PAV"},
+		{"Severo-Kazahstanskaja oblast'", "SEV"},
+		{"Shyghys Qazaqstan oblysy", "VOS"},
+		{"Shymkent", "SHY"},
+		{"Turkestankaya oblast'", "YUZ"},
+		{"Zhambyl oblysy", "ZHA"},
+	},
+	"LA": {
+		{"Attapu", "AT"},
+		{"Bokèo", "BK"},
+		{"Bolikhamxai", "BL"},
+		{"Champasak", "CH"},
+		{"Houaphan", "HO"},
+		{"Khammouan", "KH"},
+		{"Louang Namtha", "LM"},
+		{"Louangphabang", "LP"},
+		{"Oudômxai", "OU"},
+		{"Phôngsali", "PH"},
+		{"Salavan", "SL"},
+		{"Savannakhét", "SV"},
+		{"Viangchan", "VI"},
+		{"Xaignabouli", "XA"},
+		{"Xaisômboun", "XS"},
+		{"Xiangkhouang", "XI"},
+		{"Xékong", "XE"},
+	},
+	"LB": {
+		{"Aakkâr", "AK"},
+		{"Al Biqā‘", "BI"},
+		{"Al Janūb", "JA"},
+		{"An Nabaţīyah", "NA"},
+		{"Ash Shimāl", "AS"},
+		{"Baalbek-Hermel", "BH"},
+		{"Bayrūt", "BA"},
+		{"Jabal Lubnān", "JL"},
+	},
+	"LC": {
+		{"Anse la Raye", "01"},
+		{"Canaries", "12"},
+		{"Castries", "02"},
+		{"Choiseul", "03"},
+		{"Dennery", "05"},
+		{"Gros Islet", "06"},
+		{"Laborie", "07"},
+		{"Micoud", "08"},
+		{"Soufrière", "10"},
+		{"Vieux Fort", "11"},
+	},
+	"LI": {
+		{"Balzers", "01"},
+		{"Eschen", "02"},
+		{"Gamprin", "03"},
+		{"Mauren", "04"},
+		{"Planken", "05"},
+		{"Ruggell", "06"},
+		{"Schaan", "07"},
+		{"Schellenberg", "08"},
+		{"Triesen", "09"},
+		{"Triesenberg", "10"},
+		{"Vaduz", "11"},
+	},
+	"LK": {
+		{"Central Province", "2"},
+		{"Eastern Province", "5"},
+		{"North Central Province", "7"},
+		{"North Western Province", "6"},
+		{"Northern Province", "4"},
+		{"Sabaragamuwa Province", "9"},
+		{"Southern Province", "3"},
+		{"Uva Province", "8"},
+		{"Western Province", "1"},
+		{"Ampara", "52"},
+		{"Anuradhapura", "71"},
+		{"Badulla", "81"},
+		{"Batticaloa", "51"},
+		{"Colombo", "11"},
+		{"Galle", "31"},
+		{"Gampaha", "12"},
+		{"Hambantota", "33"},
+		{"Jaffna", "41"},
+		{"Kalutara", "13"},
+		{"Kandy", "21"},
+		{"Kegalla", "92"},
+		{"Kilinochchi", "42"},
+		{"Kurunegala", "61"},
+		{"Mannar", "43"},
+		{"Matale", "22"},
+		{"Matara", "32"},
+		{"Monaragala", "82"},
+		{"Mullaittivu", "45"},
+		{"Nuwara Eliya", "23"},
+		{"Polonnaruwa", "72"},
+		{"Puttalam", "62"},
+		{"Ratnapura", "91"},
+		{"Trincomalee", "53"},
+		{"Vavuniya", "44"},
+	},
+	"LR": {
+		{"Bomi", "BM"},
+		{"Bong", "BG"},
+		{"Gbarpolu", "GP"},
+		{"Grand Bassa", "GB"},
+		{"Grand Cape Mount", "CM"},
+		{"Grand Gedeh", "GG"},
+		{"Grand Kru", "GK"},
+		{"Lofa", "LO"},
+		{"Margibi", "MG"},
+		{"Maryland", "MY"},
+		{"Montserrado", "MO"},
+		{"Nimba", "NI"},
+		{"River Cess", "RI"},
+		{"River Gee", "RG"},
+		{"Sinoe", "SI"},
+	},
+	"LS": {
+		{"Berea", "D"},
+		{"Botha-Bothe", "B"},
+		{"Leribe", "C"},
+		{"Mafeteng", "E"},
+		{"Maseru", "A"},
+		{"Mohale's Hoek", "F"},
+		{"Mokhotlong", "J"},
+		{"Qacha's Nek", "H"},
+		{"Quthing", "G"},
+		{"Thaba-Tseka", "K"},
+	},
+	"LT": {
+		{"Akmenė", "01"},
+		{"Alytaus apskritis", "AL"},
+		{"Alytaus miestas", "02"},
+		{"Alytus", "03"},
+		{"Anykščiai", "04"},
+		{"Birštono", "05"},
+		{"Biržai", "06"},
+		{"Druskininkai", "07"},
+		{"Elektrėnai", "08"},
+		{"Ignalina", "09"},
+		{"Jonava", "10"},
+		{"Joniškis", "11"},
+		{"Jurbarkas", "12"},
+		{"Kaišiadorys", "13"},
+		{"Kalvarijos", "14"},
+		{"Kaunas", "16"},
+		{"Kauno apskritis", "KU"},
+		{"Kauno miestas", "15"},
+		{"Kazlų Rūdos", "17"},
+		{"Kelmė", "19"},
+		{"Klaipėda", "21"},
+		{"Klaipėdos apskritis", "KL"},
+		{"Klaipėdos miestas", "20"},
+		{"Kretinga", "22"},
+		{"Kupiškis", "23"},
+		{"Kėdainiai", "18"},
+		{"Lazdijai", "24"},
+		{"Marijampolė", "25"},
+		{"Marijampolės apskritis", "MR"},
+		{"Mažeikiai", "26"},
+		{"Molėtai", "27"},
+		{"Neringa", "28"},
+		{"Pagėgiai", "29"},
+		{"Pakruojis", "30"},
+		{"Palangos miestas", "31"},
+		{"Panevėžio apskritis", "PN"},
+		{"Panevėžio miestas", "32"},
+		{"Panevėžys", "33"},
+		{"Pasvalys", "34"},
+		{"Plungė", "35"},
+		{"Prienai", "36"},
+		{"Radviliškis", "37"},
+		{"Raseiniai", "38"},
+		{"Rietavo", "39"},
+		{"Rokiškis", "40"},
+		{"Skuodas", "48"},
+		{"Tauragė", "50"},
+		{"Tauragės apskritis", "TA"},
+		{"Telšiai", "51"},
+		{"Telšių apskritis", "TE"},
+		{"Trakai", "52"},
+		{"Ukmergė", "53"},
+		{"Utena", "54"},
+		{"Utenos apskritis", "UT"},
+		{"Varėna", "55"},
+		{"Vilkaviškis", "56"},
+		{"Vilniaus apskritis", "VL"},
+		{"Vilniaus miestas", "57"},
+		{"Vilnius", "58"},
+		{"Visaginas", "59"},
+		{"Zarasai", "60"},
+		{"Šakiai", "41"},
+		{"Šalčininkai", "42"},
+		{"Šiauliai", "44"},
+		{"Šiaulių apskritis", "SA"},
+		{"Šiaulių miestas", "43"},
+		{"Šilalė", "45"},
+		{"Šilutė", "46"},
+		{"Širvintos", "47"},
+		{"Švenčionys", "49"},
+	},
+	"LU": {
+		{"Capellen", "CA"},
+		{"Clerf", "CL"},
+		{"Diekirch", "DI"},
+		{"Echternach", "EC"},
+		{"Esch an der Alzette", "ES"},
+		{"Grevenmacher", "GR"},
+		{"Luxembourg", "LU"},
+		{"Mersch", "ME"},
+		{"Redange", "RD"},
+		{"Remich", "RM"},
+		{"Veianen", "VD"},
+		{"Wiltz", "WI"},
+	},
+	"LV": {
+		{"Aglonas novads", "001"},
+		{"Aizkraukles novads", "002"},
+		{"Aizputes novads", "003"},
+		{"Aknīstes novads", "004"},
+		{"Alojas novads", "005"},
+		{"Alsungas novads", "006"},
+		{"Alūksnes novads", "007"},
+		{"Amatas novads", "008"},
+		{"Apes novads", "009"},
+		{"Auces novads", "010"},
+		{"Babītes novads", "012"},
+		{"Baldones novads", "013"},
+		{"Baltinavas novads", "014"},
+		{"Balvu novads", "015"},
+		{"Bauskas novads", "016"},
+		{"Beverīnas novads", "017"},
+		{"Brocēnu novads", "018"},
+		{"Burtnieku novads", "019"},
+		{"Carnikavas novads", "020"},
+		{"Cesvaines novads", "021"},
+		{"Ciblas novads", "023"},
+		{"Cēsu novads", "022"},
+		{"Dagdas novads", "024"},
+		{"Daugavpils", "DGV"},
+		{"Daugavpils novads", "025"},
+		{"Dobeles novads", "026"},
+		{"Dundagas novads", "027"},
+		{"Durbes novads", "028"},
+		{"Engures novads", "029"},
+		{"Garkalnes novads", "031"},
+		{"Grobiņas novads", "032"},
+		{"Gulbenes novads", "033"},
+		{"Iecavas novads", "034"},
+		{"Ikšķiles novads", "035"},
+		{"Ilūkstes novads", "036"},
+		{"Inčukalna novads", "037"},
+		{"Jaunjelgavas novads", "038"},
+		{"Jaunpiebalgas novads", "039"},
+		{"Jaunpils novads", "040"},
+		{"Jelgava", "JEL"},
+		{"Jelgavas novads", "041"},
+		{"Jēkabpils", "JKB"},
+		{"Jēkabpils novads", "042"},
+		{"Jūrmala", "JUR"},
+		{"Kandavas novads", "043"},
+		{"Kocēnu novads", "045"},
+		{"Kokneses novads", "046"},
+		{"Krimuldas novads", "048"},
+		{"Krustpils novads", "049"},
+		{"Krāslavas novads", "047"},
+		{"Kuldīgas novads", "050"},
+		{"Kārsavas novads", "044"},
+		{"Lielvārdes novads", "053"},
+		{"Liepāja", "LPX"},
+		{"Limbažu novads", "054"},
+		{"Lubānas novads", "057"},
+		{"Ludzas novads", "058"},
+		{"Līgatnes novads", "055"},
+		{"Līvānu novads", "056"},
+		{"Madonas novads", "059"},
+		{"Mazsalacas novads", "060"},
+		{"Mālpils novads", "061"},
+		{"Mārupes novads", "062"},
+		{"Mērsraga novads", "063"},
+		{"Naukšēnu novads", "064"},
+		{"Neretas novads", "065"},
+		{"Nīcas novads", "066"},
+		{"Ogres novads", "067"},
+		{"Olaines novads", "068"},
+		{"Ozolnieku novads", "069"},
+		{"Preiļu novads", "073"},
+		{"Priekules novads", "074"},
+		{"Priekuļu novads", "075"},
+		{"Pārgaujas novads", "070"},
+		{"Pāvilostas novads", "071"},
+		{"Pļaviņu novads", "072"},
+		{"Raunas novads", "076"},
+		{"Riebiņu novads", "078"},
+		{"Rojas novads", "079"},
+		{"Ropažu novads", "080"},
+		{"Rucavas novads", "081"},
+		{"Rugāju novads", "082"},
+		{"Rundāles novads", "083"},
+		{"Rēzekne", "REZ"},
+		{"Rēzeknes novads", "077"},
+		{"Rīga", "RIX"},
+		{"Rūjienas novads", "084"},
+		{"Salacgrīvas novads", "086"},
+		{"Salas novads", "085"},
+		{"Salaspils novads", "087"},
+		{"Saldus novads", "088"},
+		{"Saulkrastu novads", "089"},
+		{"Siguldas novads", "091"},
+		{"Skrundas novads", "093"},
+		{"Skrīveru novads", "092"},
+		{"Smiltenes novads", "094"},
+		{"Stopiņu novads", "095"},
+		{"Strenču novads", "096"},
+		{"Sējas novads", "090"},
+		{"Talsu novads", "097"},
+		{"Tukuma novads", "099"},
+		{"Tērvetes novads", "098"},
+		{"Vaiņodes novads", "100"},
+		{"Valkas novads", "101"},
+		{"Valmiera", "VMR"},
+		{"Varakļānu novads", "102"},
+		{"Vecpiebalgas novads", "104"},
+		{"Vecumnieku novads", "105"},
+		{"Ventspils", "VEN"},
+		{"Ventspils novads", "106"},
+		{"Viesītes novads", "107"},
+		{"Viļakas novads", "108"},
+		{"Viļānu novads", "109"},
+		{"Vārkavas novads", "103"},
+		{"Zilupes novads", "110"},
+		{"Ādažu novads", "011"},
+		{"Ērgļu novads", "030"},
+		{"Ķeguma novads", "051"},
+		{"Ķekavas novads", "052"},
+	},
+	"LY": {
+		{"Al Buţnān", "BU"},
+		{"Al Jabal al Akhḑar", "JA"},
+		{"Al Jabal al Gharbī", "JG"},
+		{"Al Jafārah", "JI"},
+		{"Al Jufrah", "JU"},
+		{"Al Kufrah", "KF"},
+		{"Al Marj", "MJ"},
+		{"Al Marqab", "MB"},
+		{"Al Wāḩāt", "WA"},
+		{"An Nuqāţ al Khams", "NQ"},
+		{"Az Zāwiyah", "ZA"},
+		{"Banghāzī", "BA"},
+		{"Darnah", "DR"},
+		{"Ghāt", "GT"},
+		{"Mişrātah", "MI"},
+		{"Murzuq", "MQ"},
+		{"Nālūt", "NL"},
+		{"Sabhā", "SB"},
+		{"Surt", "SR"},
+		{"Wādī al Ḩayāt", "WD"},
+		{"Wādī ash Shāţi’", "WS"},
+		{"Ţarābulus", "TB"},
+	},
+	"MA": {
+		{"Béni Mellal-Khénifra", "05"},
+		{"Casablanca-Settat", "06"},
+		{"Dakhla-Oued Ed-Dahab (EH)", "12"},
+		{"Drâa-Tafilalet", "08"},
+		{"Fès-Meknès", "03"},
+		{"Guelmim-Oued Noun (EH-partial)", "10"},
+		{"L'Oriental", "02"},
+		{"Laâyoune-Sakia El Hamra (EH-partial)", "11"},
+		{"Marrakech-Safi", "07"},
+		{"Rabat-Salé-Kénitra", "04"},
+		{"Souss-Massa", "09"},
+		{"Tanger-Tétouan-Al Hoceïma", "01"},
+		{"Dakhla-Oued Ed-Dahab", "12"},
+		{"Guelmim-Oued Noun", "10"},
+		{"Laâyoune-Sakia El Hamra", "11"},
+		{"Agadir-Ida-Ou-Tanane", "AGD"},
+		{"Al Haouz", "HAO"},
+		{"Al Hoceïma", "HOC"},
+		{"Aousserd (EH)", "AOU"},
+		{"Assa-Zag (EH-partial)", "ASZ"},
+		{"Azilal", "AZI"},
+		{"Benslimane", "BES"},
+		{"Berkane", "BER"},
+		{"Berrechid", "BRR"},
+		{"Boujdour (EH)", "BOD"},
+		{"Boulemane", "BOM"},
+		{"Béni Mellal", "BEM"},
+		{"Casablanca", "CAS"},
+		{"Chefchaouen", "CHE"},
+		{"Chichaoua", "CHI"},
+		{"Chtouka-Ait Baha", "CHT"},
+		{"Driouch", "DRI"},
+		{"El Hajeb", "HAJ"},
+		{"El Jadida", "JDI"},
+		{"El Kelâa des Sraghna", "KES"},
+		{"Errachidia", "ERR"},
+		{"Es-Semara (EH-partial)", "ESM"},
+		{"Essaouira", "ESI"},
+		{"Fahs-Anjra", "FAH"},
+		{"Figuig", "FIG"},
+		{"Fquih Ben Salah", "FQH"},
+		{"Fès", "FES"},
+		{"Guelmim", "GUE"},
+		{"Guercif", "GUF"},
+		{"Ifrane", "IFR"},
+		{"Inezgane-Ait Melloul", "INE"},
+		{"Jerada", "JRA"},
+		{"Khouribga", "KHO"},
+		{"Khémisset", "KHE"},
+		{"Khénifra", "KHN"},
+		{"Kénitra", "KEN"},
+		{"Larache", "LAR"},
+		{"Laâyoune (EH)", "LAA"},
+		{"Marrakech", "MAR"},
+		{"Meknès", "MEK"},
+		{"Midelt", "MID"},
+		{"Mohammadia", "MOH"},
+		{"Moulay Yacoub", "MOU"},
+		{"Médiouna", "MED"},
+		{"M’diq-Fnideq", "MDF"},
+		{"Nador", "NAD"},
+		{"Nouaceur", "NOU"},
+		{"Ouarzazate", "OUA"},
+		{"Oued Ed-Dahab (EH)", "OUD"},
+		{"Ouezzane", "OUZ"},
+		{"Oujda-Angad", "OUJ"},
+		{"Rabat", "RAB"},
+		{"Rehamna", "REH"},
+		{"Safi", "SAF"},
+		{"Salé", "SAL"},
+		{"Sefrou", "SEF"},
+		{"Settat", "SET"},
+		{"Sidi Bennour", "SIB"},
+		{"Sidi Ifni", "SIF"},
+		{"Sidi Kacem", "SIK"},
+		{"Sidi Slimane", "SIL"},
+		{"Skhirate-Témara", "SKH"},
+		{"Tan-Tan (EH-partial)", "TNT"},
+		{"Tanger-Assilah", "TNG"},
+		{"Taounate", "TAO"},
+		{"Taourirt", "TAI"},
+		{"Tarfaya (EH-partial)", "TAF"},
+		{"Taroudannt", "TAR"},
+		{"Tata", "TAT"},
+		{"Taza", "TAZ"},
+		{"Tinghir", "TIN"},
+		{"Tiznit", "TIZ"},
+		{"Tétouan", "TET"},
+		{"Youssoufia", "YUS"},
+		{"Zagora", "ZAG"},
+		{"Aousserd", "AOU"},
+		{"Assa-Zag", "ASZ"},
+		{"Boujdour", "BOD"},
+		{"Es-Semara", "ESM"},
+		{"Laâyoune", "LAA"},
+		{"Oued Ed-Dahab", "OUD"},
+		{"Tan-Tan", "TNT"},
+		{"Tarfaya", "TAF"},
+	},
+	"MC": {
+		{"Fontvieille", "FO"},
+		{"Jardin Exotique", "JE"},
+		{"La Colle", "CL"},
+		{"La Condamine", "CO"},
+		{"La Gare", "GA"},
+		{"La Source", "SO"},
+		{"Larvotto", "LA"},
+		{"Malbousquet", "MA"},
+		{"Monaco-Ville", "MO"},
+		{"Moneghetti", "MG"},
+		{"Monte-Carlo", "MC"},
+		{"Moulins", "MU"},
+		{"Port-Hercule", "PH"},
+		{"Saint-Roman", "SR"},
+		{"Sainte-Dévote", "SD"},
+		{"Spélugues", "SP"},
+		{"Vallon de la Rousse", "VR"},
+	},
+	"MD": {
+		{"Anenii Noi", "AN"},
+		{"Basarabeasca", "BS"},
+		{"Bender", "BD"},
+		{"Briceni", "BR"},
+		{"Bălți", "BA"},
+		{"Cahul", "CA"},
+		{"Cantemir", "CT"},
+		{"Chișinău", "CU"},
+		{"Cimișlia", "CM"},
+		{"Criuleni", "CR"},
+		{"Călărași", "CL"},
+		{"Căușeni", "CS"},
+		{"Dondușeni", "DO"},
+		{"Drochia", "DR"},
+		{"Dubăsari", "DU"},
+		{"Edineț", "ED"},
+		{"Florești", "FL"},
+		{"Fălești", "FA"},
+		{"Glodeni", "GL"},
+		{"Găgăuzia, Unitatea teritorială autonomă (UTAG)", "GA"},
+		{"Hîncești", "HI"},
+		{"Ialoveni", "IA"},
+		{"Leova", "LE"},
+		{"Nisporeni", "NI"},
+		{"Ocnița", "OC"},
+		{"Orhei", "OR"},
+		{"Rezina", "RE"},
+		{"Rîșcani", "RI"},
+		{"Soroca", "SO"},
+		{"Strășeni", "ST"},
+		{"Stînga Nistrului, unitatea teritorială din", "SN"},
+		{"Sîngerei", "SI"},
+		{"Taraclia", "TA"},
+		{"Telenești", "TE"},
+		{"Ungheni", "UN"},
+		{"Șoldănești", "SD"},
+		{"Ștefan Vodă", "SV"},
+		{"Găgăuzia", "GA"},
+		{"Găgăuzia, Unitatea teritorială autonomă", "GA"},
+		{"Stînga Nistrului", "SN"},
+		{"Tighina", "BD"},
+		{"Unitatea teritorială autonomă (UTAG) Găgăuzia", "GA"},
+		{"unitatea teritorială din Stînga Nistrului", "SN"},
+	},
+	"ME": {
+		{"Andrijevica", "01"},
+		{"Bar", "02"},
+		{"Berane", "03"},
+		{"Bijelo Polje", "04"},
+		{"Budva", "05"},
+		{"Cetinje", "06"},
+		{"Danilovgrad", "07"},
+		{"Gusinje", "22"},
+		{"Herceg-Novi", "08"},
+		{"Kolašin", "09"},
+		{"Kotor", "10"},
+		{"Mojkovac", "11"},
+		{"Nikšić", "12"},
+		{"Petnjica", "23"},
+		{"Plav", "13"},
+		{"Pljevlja", "14"},
+		{"Plužine", "15"},
+		{"Podgorica", "16"},
+		{"Rožaje", "17"},
+		{"Tivat", "19"},
+		{"Tuzi", "24"},
+		{"Ulcinj", "20"},
+		{"Šavnik", "18"},
+		{"Žabljak", "21"},
+	},
+	"MG": {
+		{"Antananarivo", "T"},
+		{"Antsiranana", "D"},
+		{"Fianarantsoa", "F"},
+		{"Mahajanga", "M"},
+		{"Toamasina", "A"},
+		{"Toliara", "U"},
+	},
+	"MH": {
+		{"Ralik chain", "L"},
+		{"Ratak chain", "T"},
+		{"Ailinglaplap", "ALL"},
+		{"Ailuk", "ALK"},
+		{"Arno", "ARN"},
+		{"Aur", "AUR"},
+		{"Bikini & Kili", "KIL"},
+		{"Ebon", "EBO"},
+		{"Enewetak & Ujelang", "ENI"},
+		{"Jabat", "JAB"},
+		{"Jaluit", "JAL"},
+		{"Kwajalein", "KWA"},
+		{"Lae", "LAE"},
+		{"Lib", "LIB"},
+		{"Likiep", "LIK"},
+		{"Majuro", "MAJ"},
+		{"Maloelap", "MAL"},
+		{"Mejit", "MEJ"},
+		{"Mili", "MIL"},
+		{"Namdrik", "NMK"},
+		{"Namu", "NMU"},
+		{"Rongelap", "RON"},
+		{"Ujae", "UJA"},
+		{"Utrik", "UTI"},
+		{"Wotho", "WTH"},
+		{"Wotje", "WTJ"},
+	},
+	"MK": {
+		{"Aerodrom †", "801"},
+		{"Aračinovo", "802"},
+		{"Berovo", "201"},
+		{"Bitola", "501"},
+		{"Bogdanci", "401"},
+		{"Bogovinje", "601"},
+		{"Bosilovo", "402"},
+		{"Brvenica", "602"},
+		{"Butel †", "803"},
+		{"Centar Župa", "313"},
+		{"Centar †", "814"},
+		{"Debar", "303"},
+		{"Debrca", "304"},
+		{"Delčevo", "203"},
+		{"Demir Hisar", "502"},
+		{"Demir Kapija", "103"},
+		{"Dojran", "406"},
+		{"Dolneni", "503"},
+		{"Gazi Baba †", "804"},
+		{"Gevgelija", "405"},
+		{"Gjorče Petrov †", "805"},
+		{"Gostivar", "604"},
+		{"Gradsko", "102"},
+		{"Ilinden", "807"},
+		{"Jegunovce", "606"},
+		{"Karbinci", "205"},
+		{"Karpoš †", "808"},
+		{"Kavadarci", "104"},
+		{"Kisela Voda †", "809"},
+		{"Kičevo", "307"},
+		{"Konče", "407"},
+		{"Kočani", "206"},
+		{"Kratovo", "701"},
+		{"Kriva Palanka", "702"},
+		{"Krivogaštani", "504"},
+		{"Kruševo", "505"},
+		{"Kumanovo", "703"},
+		{"Lipkovo", "704"},
+		{"Lozovo", "105"},
+		{"Makedonska Kamenica", "207"},
+		{"Makedonski Brod", "308"},
+		{"Mavrovo i Rostuše", "607"},
+		{"Mogila", "506"},
+		{"Negotino", "106"},
+		{"Novaci", "507"},
+		{"Novo Selo", "408"},
+		{"Ohrid", "310"},
+		{"Pehčevo", "208"},
+		{"Petrovec", "810"},
+		{"Plasnica", "311"},
+		{"Prilep", "508"},
+		{"Probištip", "209"},
+		{"Radoviš", "409"},
+		{"Rankovce", "705"},
+		{"Resen", "509"},
+		{"Rosoman", "107"},
+		{"Saraj †", "811"},
+		{"Sopište", "812"},
+		{"Staro Nagoričane", "706"},
+		{"Struga", "312"},
+		{"Strumica", "410"},
+		{"Studeničani", "813"},
+		{"Sveti Nikole", "108"},
+		{"Tearce", "608"},
+		{"Tetovo", "609"},
+		{"Valandovo", "403"},
+		{"Vasilevo", "404"},
+		{"Veles", "101"},
+		{"Vevčani", "301"},
+		{"Vinica", "202"},
+		{"Vrapčište", "603"},
+		{"Zelenikovo", "806"},
+		{"Zrnovci", "204"},
+		{"Čair †", "815"},
+		{"Čaška", "109"},
+		{"Češinovo-Obleševo", "210"},
+		{"Čučer-Sandevo", "816"},
+		{"Štip", "211"},
+		{"Šuto Orizari †", "817"},
+		{"Želino", "605"},
+	},
+	"ML": {
+		{"Bamako", "BKO"},
+		{"Gao", "7"},
+		{"Kayes", "1"},
+		{"Kidal", "8"},
+		{"Koulikoro", "2"},
+		{"Mopti", "5"},
+		{"Ménaka", "9"},
+		{"Sikasso", "3"},
+		{"Ségou", "4"},
+		{"Taoudénit", "10"},
+		{"Tombouctou", "6"},
+	},
+	"MM": {
+		{"Ayeyarwady", "07"},
+		{"Bago", "02"},
+		{"Chin", "14"},
+		{"Kachin", "11"},
+		{"Kayah", "12"},
+		{"Kayin", "13"},
+		{"Magway", "03"},
+		{"Mandalay", "04"},
+		{"Mon", "15"},
+		{"Nay Pyi Taw", "18"},
+		{"Rakhine", "16"},
+		{"Sagaing", "01"},
+		{"Shan", "17"},
+		{"Tanintharyi", "05"},
+		{"Yangon", "06"},
+	},
+	"MN": {
+		{"Arhangay", "073"},
+		{"Bayan-Ölgiy", "071"},
+		{"Bayanhongor", "069"},
+		{"Bulgan", "067"},
+		{"Darhan uul", "037"},
+		{"Dornod", "061"},
+		{"Dornogovĭ", "063"},
+		{"Dundgovĭ", "059"},
+		{"Dzavhan", "057"},
+		{"Govĭ-Altay", "065"},
+		{"Govĭ-Sümber", "064"},
+		{"Hentiy", "039"},
+		{"Hovd", "043"},
+		{"Hövsgöl", "041"},
+		{"Orhon", "035"},
+		{"Selenge", "049"},
+		{"Sühbaatar", "051"},
+		{"Töv", "047"},
+		{"Ulaanbaatar", "1"},
+		{"Uvs", "046"},
+		{"Ömnögovĭ", "053"},
+		{"Övörhangay", "055"},
+	},
+	"MR": {
+		{"Adrar", "07"},
+		{"Assaba", "03"},
+		{"Brakna", "05"},
+		{"Dakhlet Nouâdhibou", "08"},
+		{"Gorgol", "04"},
+		{"Guidimaka", "10"},
+		{"Hodh ech Chargui", "01"},
+		{"Hodh el Gharbi", "02"},
+		{"Inchiri", "12"},
+		{"Nouakchott Nord", "14"},
+		{"Nouakchott Ouest", "13"},
+		{"Nouakchott Sud", "15"},
+		{"Tagant", "09"},
+		{"Tiris Zemmour", "11"},
+		{"Trarza", "06"},
+	},
+	"MT": {
+		{"Attard", "01"},
+		{"Balzan", "02"},
+		{"Birgu", "03"},
+		{"Birkirkara", "04"},
+		{"Birżebbuġa", "05"},
+		{"Bormla", "06"},
+		{"Dingli", "07"},
+		{"Fgura", "08"},
+		{"Floriana", "09"},
+		{"Fontana", "10"},
+		{"Gudja", "11"},
+		{"Għajnsielem", "13"},
+		{"Għarb", "14"},
+		{"Għargħur", "15"},
+		{"Għasri", "16"},
+		{"Għaxaq", "17"},
+		{"Gżira", "12"},
+		{"Iklin", "19"},
+		{"Isla", "20"},
+		{"Kalkara", "21"},
+		{"Kerċem", "22"},
+		{"Kirkop", "23"},
+		{"Lija", "24"},
+		{"Luqa", "25"},
+		{"Marsa", "26"},
+		{"Marsaskala", "27"},
+		{"Marsaxlokk", "28"},
+		{"Mdina", "29"},
+		{"Mellieħa", "30"},
+		{"Mosta", "32"},
+		{"Mqabba", "33"},
+		{"Msida", "34"},
+		{"Mtarfa", "35"},
+		{"Munxar", "36"},
+		{"Mġarr", "31"},
+		{"Nadur", "37"},
+		{"Naxxar", "38"},
+		{"Paola", "39"},
+		{"Pembroke", "40"},
+		{"Pietà", "41"},
+		{"Qala", "42"},
+		{"Qormi", "43"},
+		{"Qrendi", "44"},
+		{"Rabat Gozo", "45"},
+		{"Rabat Malta", "46"},
+		{"Safi", "47"},
+		{"Saint John", "49"},
+		{"Saint Julian's", "48"},
+		{"Saint Lawrence", "50"},
+		{"Saint Lucia's", "53"},
+		{"Saint Paul's Bay", "51"},
+		{"Sannat", "52"},
+		{"Santa Venera", "54"},
+		{"Siġġiewi", "55"},
+		{"Sliema", "56"},
+		{"Swieqi", "57"},
+		{"Ta' Xbiex", "58"},
+		{"Tarxien", "59"},
+		{"Valletta", "60"},
+		{"Xagħra", "61"},
+		{"Xewkija", "62"},
+		{"Xgħajra", "63"},
+		{"Ħamrun", "18"},
+		{"Żabbar", "64"},
+		{"Żebbuġ Gozo", "65"},
+		{"Żebbuġ Malta", "66"},
+		{"Żejtun", "67"},
+		{"Żurrieq", "68"},
+	},
+	"MU": {
+		{"Agalega Islands", "AG"},
+		{"Black River", "BL"},
+		{"Cargados Carajos Shoals", "CC"},
+		{"Flacq", "FL"},
+		{"Grand Port", "GP"},
+		{"Moka", "MO"},
+		{"Pamplemousses", "PA"},
+		{"Plaines Wilhems", "PW"},
+		{"Port Louis", "PL"},
+		{"Rivière du Rempart", "RR"},
+		{"Rodrigues Island", "RO"},
+		{"Savanne", "SA"},
+	},
+	"MV": {
+		{"Addu City", "01"},
+		{"Faadhippolhu", "03"},
+		{"Felidhu Atoll", "04"},
+		{"Fuvammulah", "29"},
+		{"Hahdhunmathi", "05"},
+		{"Kolhumadulu", "08"},
+		{"Male", "MLE"},
+		{"Male Atoll", "26"},
+		{"Mulaku Atoll", "12"},
+		{"North Ari Atoll", "02"},
+		{"North Huvadhu Atoll", "27"},
+		{"North Maalhosmadulu", "13"},
+		{"North Miladhunmadulu", "24"},
+		{"North Nilandhe Atoll", "14"},
+		{"North Thiladhunmathi", "07"},
+		{"South Ari Atoll", "00"},
+		{"South Huvadhu Atoll", "28"},
+		{"South Maalhosmadulu", "20"},
+		{"South Miladhunmadulu", "25"},
+		{"South Nilandhe Atoll", "17"},
+		{"South Thiladhunmathi", "23"},
+	},
+	"MW": {
+		{"Central Region", "C"},
+		{"Northern Region", "N"},
+		{"Southern Region", "S"},
+		{"Balaka", "BA"},
+		{"Blantyre", "BL"},
+		{"Chikwawa", "CK"},
+		{"Chiradzulu", "CR"},
+		{"Chitipa", "CT"},
+		{"Dedza", "DE"},
+		{"Dowa", "DO"},
+		{"Karonga", "KR"},
+		{"Kasungu", "KS"},
+		{"Likoma", "LK"},
+		{"Lilongwe", "LI"},
+		{"Machinga", "MH"},
+		{"Mangochi", "MG"},
+		{"Mchinji", "MC"},
+		{"Mulanje", "MU"},
+		{"Mwanza", "MW"},
+		{"Mzimba", "MZ"},
+		{"Neno", "NE"},
+		{"Nkhata Bay", "NB"},
+		{"Nkhotakota", "NK"},
+		{"Nsanje", "NS"},
+		{"Ntcheu", "NU"},
+		{"Ntchisi", "NI"},
+		{"Phalombe", "PH"},
+		{"Rumphi", "RU"},
+		{"Salima", "SA"},
+		{"Thyolo", "TH"},
+		{"Zomba", "ZO"},
+	},
+	"MX": {
+		{"Aguascalientes", "AGU"},
+		{"Baja California", "BCN"},
+		{"Baja California Sur", "BCS"},
+		{"Campeche", "CAM"},
+		{"Chiapas", "CHP"},
+		{"Chihuahua", "CHH"},
+		{"Ciudad de México", "CMX"},
+		{"Coahuila de Zaragoza", "COA"},
+		{"Colima", "COL"},
+		{"Durango", "DUR"},
+		{"Guanajuato", "GUA"},
+		{"Guerrero", "GRO"},
+		{"Hidalgo", "HID"},
+		{"Jalisco", "JAL"},
+		{"Michoacán de Ocampo", "MIC"},
+		{"Morelos", "MOR"},
+		{"México", "MEX"},
+		{"Nayarit", "NAY"},
+		{"Nuevo León", "NLE"},
+		{"Oaxaca", "OAX"},
+		{"Puebla", "PUE"},
+		{"Querétaro", "QUE"},
+		{"Quintana Roo", "ROO"},
+		{"San Luis Potosí", "SLP"},
+		{"Sinaloa", "SIN"},
+		{"Sonora", "SON"},
+		{"Tabasco", "TAB"},
+		{"Tamaulipas", "TAM"},
+		{"Tlaxcala", "TLA"},
+		{"Veracruz de Ignacio de la Llave", "VER"},
+		{"Yucatán", "YUC"},
+		{"Zacatecas", "ZAC"},
+	},
+	"MY": {
+		{"Johor", "01"},
+		{"Kedah", "02"},
+		{"Kelantan", "03"},
+		{"Melaka", "04"},
+		{"Negeri Sembilan", "05"},
+		{"Pahang", "06"},
+		{"Perak", "08"},
+		{"Perlis", "09"},
+		{"Pulau Pinang", "07"},
+		{"Sabah", "12"},
+		{"Sarawak", "13"},
+		{"Selangor", "10"},
+		{"Terengganu", "11"},
+		{"Wilayah Persekutuan Kuala Lumpur", "14"},
+		{"Wilayah Persekutuan Labuan", "15"},
+		{"Wilayah Persekutuan Putrajaya", "16"},
+	},
+	"MZ": {
+		{"Cabo Delgado", "P"},
+		{"Gaza", "G"},
+		{"Inhambane", "I"},
+		{"Manica", "B"},
+		{"Maputo", "L"},
+		{"Nampula", "N"},
+		{"Niassa", "A"},
+		{"Sofala", "S"},
+		{"Tete", "T"},
+		{"Zambézia", "Q"},
+	},
+	"NA": {
+		{"//Karas", "KA"},
+		{"Erongo", "ER"},
+		{"Hardap", "HA"},
+		{"Kavango East", "KE"},
+		{"Kavango West", "KW"},
+		{"Khomas", "KH"},
+		{"Kunene", "KU"},
+		{"Ohangwena", "OW"},
+		{"Omaheke", "OH"},
+		{"Omusati", "OS"},
+		{"Oshana", "ON"},
+		{"Oshikoto", "OT"},
+		{"Otjozondjupa", "OD"},
+		{"Zambezi", "CA"},
+	},
+	"NE": {
+		{"Agadez", "1"},
+		{"Diffa", "2"},
+		{"Dosso", "3"},
+		{"Maradi", "4"},
+		{"Niamey", "8"},
+		{"Tahoua", "5"},
+		{"Tillabéri", "6"},
+		{"Zinder", "7"},
+	},
+	"NG": {
+		{"Abia", "AB"},
+		{"Abuja Federal Capital Territory", "FC"},
+		{"Adamawa", "AD"},
+		{"Akwa Ibom", "AK"},
+		{"Anambra", "AN"},
+		{"Bauchi", "BA"},
+		{"Bayelsa", "BY"},
+		{"Benue", "BE"},
+		{"Borno", "BO"},
+		{"Cross River", "CR"},
+		{"Delta", "DE"},
+		{"Ebonyi", "EB"},
+		{"Edo", "ED"},
+		{"Ekiti", "EK"},
+		{"Enugu", "EN"},
+		{"Gombe", "GO"},
+		{"Imo", "IM"},
+		{"Jigawa", "JI"},
+		{"Kaduna", "KD"},
+		{"Kano", "KN"},
+		{"Katsina", "KT"},
+		{"Kebbi", "KE"},
+		{"Kogi", "KO"},
+		{"Kwara", "KW"},
+		{"Lagos", "LA"},
+		{"Nasarawa", "NA"},
+		{"Niger", "NI"},
+		{"Ogun", "OG"},
+		{"Ondo", "ON"},
+		{"Osun", "OS"},
+		{"Oyo", "OY"},
+		{"Plateau", "PL"},
+		{"Rivers", "RI"},
+		{"Sokoto", "SO"},
+		{"Taraba", "TA"},
+		{"Yobe", "YO"},
+		{"Zamfara", "ZA"},
+	},
+	"NI": {
+		{"Boaco", "BO"},
+		{"Carazo", "CA"},
+		{"Chinandega", "CI"},
+		{"Chontales", "CO"},
+		{"Costa Caribe Norte", "AN"},
+		{"Costa Caribe Sur", "AS"},
+		{"Estelí", "ES"},
+		{"Granada", "GR"},
+		{"Jinotega", "JI"},
+		{"León", "LE"},
+		{"Madriz", "MD"},
+		{"Managua", "MN"},
+		{"Masaya", "MS"},
+		{"Matagalpa", "MT"},
+		{"Nueva Segovia", "NS"},
+		{"Rivas", "RI"},
+		{"Río San Juan", "SJ"},
+	},
+	"NL": {
+		{"Aruba", "AW"},
+		{"Bonaire", "BQ1"},
+		{"Curaçao", "CW"},
+		{"Drenthe", "DR"},
+		{"Flevoland", "FL"},
+		{"Fryslân", "FR"},
+		{"Gelderland", "GE"},
+		{"Groningen", "GR"},
+		{"Limburg", "LI"},
+		{"Noord-Brabant", "NB"},
+		{"Noord-Holland", "NH"},
+		{"Overijssel", "OV"},
+		{"Saba", "BQ2"},
+		{"Sint Eustatius", "BQ3"},
+		{"Sint Maarten", "SX"},
+		{"Utrecht", "UT"},
+		{"Zeeland", "ZE"},
+		{"Zuid-Holland", "ZH"},
+	},
+	"NO": {
+		{"Agder", "42"},
+		{"Innlandet", "34"},
+		{"Jan Mayen (Arctic Region)", "22"},
+		{"Møre og Romsdal", "15"},
+		{"Nordland", "18"},
+		{"Oslo", "03"},
+		{"Rogaland", "11"},
+		{"Romssa ja Finnmárkku", "54"},
+		{"Svalbard (Arctic Region)", "21"},
+		{"Trööndelage", "50"},
+		{"Vestfold og Telemark", "38"},
+		{"Vestland", "46"},
+		{"Viken", "30"},
+		{"Jan Mayen", "22"},
+		{"Svalbard", "21"},
+	},
+	"NP": {
+		{"Bāgmatī", "P3"},
+		{"Central", "1"},
+		{"Eastern", "4"},
+		{"Far Western", "5"},
+		{"Gandaki", "P4"},
+		{"Karnali", "P6"},
+		{"Mid Western", "2"},
+		{"Province 1", "P1"},
+		{"Province 2", "P2"},
+		{"Province 5", "P5"},
+		{"Sudūr Pashchim", "P7"},
+		{"Western", "3"},
+		{"Bagmati", "BA"},
+		{"Bheri", "BH"},
+		{"Dhawalagiri", "DH"},
+		{"Janakpur", "JA"},
+		{"Kosi", "KO"},
+		{"Lumbini", "LU"},
+		{"Mahakali", "MA"},
+		{"Mechi", "ME"},
+		{"Narayani", "NA"},
+		{"Rapti", "RA"},
+		{"Sagarmatha", "SA"},
+		{"Seti", "SE"},
+	},
+	"NR": {
+		{"Aiwo", "01"},
+		{"Anabar", "02"},
+		{"Anetan", "03"},
+		{"Anibare", "04"},
+		{"Baitsi", "05"},
+		{"Boe", "06"},
+		{"Buada", "07"},
+		{"Denigomodu", "08"},
+		{"Ewa", "09"},
+		{"Ijuw", "10"},
+		{"Meneng", "11"},
+		{"Nibok", "12"},
+		{"Uaboe", "13"},
+		{"Yaren", "14"},
+	},
+	"NZ": {
+		{"Auckland", "AUK"},
+		{"Bay of Plenty", "BOP"},
+		{"Canterbury", "CAN"},
+		{"Chatham Islands Territory", "CIT"},
+		{"Gisborne", "GIS"},
+		{"Hawke's Bay", "HKB"},
+		{"Manawatu-Wanganui", "MWT"},
+		{"Marlborough", "MBH"},
+		{"Nelson", "NSN"},
+		{"Northland", "NTL"},
+		{"Otago", "OTA"},
+		{"Southland", "STL"},
+		{"Taranaki", "TKI"},
+		{"Tasman", "TAS"},
+		{"Waikato", "WKO"},
+		{"Wellington", "WGN"},
+		{"West Coast", "WTC"},
+	},
+	"OM": {
+		{"Ad Dākhilīyah", "DA"},
+		{"Al Buraymī", "BU"},
+		{"Al Wusţá", "WU"},
+		{"Az̧ Z̧āhirah", "ZA"},
+		{"Janūb al Bāţinah", "BJ"},
+		{"Janūb ash Sharqīyah", "SJ"},
+		{"Masqaţ", "MA"},
+		{"Musandam", "MU"},
+		{"Shamāl al Bāţinah", "BS"},
+		{"Shamāl ash Sharqīyah", "SS"},
+		{"Z̧ufār", "ZU"},
+	},
+	"PA": {
+		{"Bocas del Toro", "1"},
+		{"Chiriquí", "4"},
+		{"Coclé", "2"},
+		{"Colón", "3"},
+		{"Darién", "5"},
+		{"Emberá", "EM"},
+		{"Guna Yala", "KY"},
+		{"Herrera", "6"},
+		{"Los Santos", "7"},
+		{"Ngöbe-Buglé", "NB"},
+		{"Panamá", "8"},
+		{"Panamá Oeste", "10"},
+		{"Veraguas", "9"},
+	},
+	"PE": {
+		{"Amarumayu", "AMA"},
+		{"Ancash", "ANC"},
+		{"Apurimaq", "APU"},
+		{"Arequipa", "ARE"},
+		{"Ayacucho", "AYA"},
+		{"Cajamarca", "CAJ"},
+		{"Cusco", "CUS"},
+		{"El Callao", "CAL"},
+		{"Huancavelica", "HUV"},
+		{"Hunin", "JUN"},
+		{"Huánuco", "HUC"},
+		{"Ica", "ICA"},
+		{"La Libertad", "LAL"},
+		{"Lambayeque", "LAM"},
+		{"Lima", "LIM"},
+		{"Lima hatun llaqta", "LMA"},
+		{"Loreto", "LOR"},
+		{"Madre de Dios", "MDD"},
+		{"Moquegua", "MOQ"},
+		{"Pasco", "PAS"},
+		{"Piura", "PIU"},
+		{"Puno", "PUN"},
+		{"San Martin", "SAM"},
+		{"Tacna", "TAC"},
+		{"Tumbes", "TUM"},
+		{"Ucayali", "UCA"},
+	},
+	"PG": {
+		{"Bougainville", "NSB"},
+		{"Central", "CPM"},
+		{"Chimbu", "CPK"},
+		{"East New Britain", "EBR"},
+		{"East Sepik", "ESW"},
+		{"Eastern Highlands", "EHG"},
+		{"Enga", "EPW"},
+		{"Gulf", "GPK"},
+		{"Hela", "HLA"},
+		{"Jiwaka", "JWK"},
+		{"Madang", "MPM"},
+		{"Manus", "MRL"},
+		{"Milne Bay", "MBA"},
+		{"Morobe", "MPL"},
+		{"National Capital District (Port Moresby)", "NCD"},
+		{"New Ireland", "NIK"},
+		{"Northern", "NPP"},
+		{"Southern Highlands", "SHM"},
+		{"West New Britain", "WBK"},
+		{"West Sepik", "SAN"},
+		{"Western", "WPD"},
+		{"Western Highlands", "WHM"},
+		{"National Capital District", "NCD"},
+	},
+	"PH": {
+		{"Autonomous Region in Muslim Mindanao (ARMM)", "14"},
+		{"Bicol (Region V)", "05"},
+		{"Cagayan Valley (Region II)", "02"},
+		{"Calabarzon (Region IV-A)", "40"},
+		{"Caraga (Region XIII)", "13"},
+		{"Central Luzon (Region III)", "03"},
+		{"Central Visayas (Region VII)", "07"},
+		{"Cordillera Administrative Region (CAR)", "15"},
+		{"Davao (Region XI)", "11"},
+		{"Eastern Visayas (Region VIII)", "08"},
+		{"Ilocos (Region I)", "01"},
+		{"Mimaropa (Region IV-B)", "41"},
+		{"National Capital Region", "00"},
+		{"Northern Mindanao (Region X)", "10"},
+		{"Soccsksargen (Region XII)", "12"},
+		{"Western Visayas (Region VI)", "06"},
+		{"Zamboanga Peninsula (Region IX)", "09"},
+		{"Autonomous Region in Muslim Mindanao", "14"},
+		{"Bicol", "05"},
+		{"Cagayan Valley", "02"},
+		{"Calabarzon", "40"},
+		{"Caraga", "13"},
+		{"Central Luzon", "03"},
+		{"Central Visayas", "07"},
+		{"Cordillera Administrative Region", "15"},
+		{"Davao", "11"},
+		{"Eastern Visayas", "08"},
+		{"Ilocos", "01"},
+		{"Mimaropa", "41"},
+		{"Northern Mindanao", "10"},
+		{"Soccsksargen", "12"},
+		{"Western Visayas", "06"},
+		{"Zamboanga Peninsula", "09"},
+		{"Abra", "ABR"},
+		{"Agusan del Norte", "AGN"},
+		{"Agusan del Sur", "AGS"},
+		{"Aklan", "AKL"},
+		{"Albay", "ALB"},
+		{"Antique", "ANT"},
+		{"Apayao", "APA"},
+		{"Aurora", "AUR"},
+		{"Basilan", "BAS"},
+		{"Bataan", "BAN"},
+		{"Batanes", "BTN"},
+		{"Batangas", "BTG"},
+		{"Benguet", "BEN"},
+		{"Biliran", "BIL"},
+		{"Bohol", "BOH"},
+		{"Bukidnon", "BUK"},
+		{"Bulacan", "BUL"},
+		{"Cagayan", "CAG"},
+		{"Camarines Norte", "CAN"},
+		{"Camarines Sur", "CAS"},
+		{"Camiguin", "CAM"},
+		{"Capiz", "CAP"},
+		{"Catanduanes", "CAT"},
+		{"Cavite", "CAV"},
+		{"Cebu", "CEB"},
+		{"Cotabato", "NCO"},
+		{"Davao Occidental", "DVO"},
+		{"Davao Oriental", "DAO"},
+		{"Davao de Oro", "COM"},
+		{"Davao del Norte", "DAV"},
+		{"Davao del Sur", "DAS"},
+		{"Dinagat Islands", "DIN"},
+		{"Eastern Samar", "EAS"},
+		{"Guimaras", "GUI"},
+		{"Ifugao", "IFU"},
+		{"Ilocos Norte", "ILN"},
+		{"Ilocos Sur", "ILS"},
+		{"Iloilo", "ILI"},
+		{"Isabela", "ISA"},
+		{"Kalinga", "KAL"},
+		{"La Union", "LUN"},
+		{"Laguna", "LAG"},
+		{"Lanao del Norte", "LAN"},
+		{"Lanao del Sur", "LAS"},
+		{"Leyte", "LEY"},
+		{"Maguindanao", "MAG"},
+		{"Marinduque", "MAD"},
+		{"Masbate", "MAS"},
+		{"Mindoro Occidental", "MDC"},
+		{"Mindoro Oriental", "MDR"},
+		{"Misamis Occidental", "MSC"},
+		{"Misamis Oriental", "MSR"},
+		{"Mountain Province", "MOU"},
+		{"Negros Occidental", "NEC"},
+		{"Negros Oriental", "NER"},
+		{"Northern Samar", "NSA"},
+		{"Nueva Ecija", "NUE"},
+		{"Nueva Vizcaya", "NUV"},
+		{"Palawan", "PLW"},
+		{"Pampanga", "PAM"},
+		{"Pangasinan", "PAN"},
+		{"Quezon", "QUE"},
+		{"Quirino", "QUI"},
+		{"Rizal", "RIZ"},
+		{"Romblon", "ROM"},
+		{"Samar", "WSA"},
+		{"Sarangani", "SAR"},
+		{"Siquijor", "SIG"},
+		{"Sorsogon", "SOR"},
+		{"South Cotabato", "SCO"},
+		{"Southern Leyte", "SLE"},
+		{"Sultan Kudarat", "SUK"},
+		{"Sulu", "SLU"},
+		{"Surigao del Norte", "SUN"},
+		{"Surigao del Sur", "SUR"},
+		{"Tarlac", "TAR"},
+		{"Tawi-Tawi", "TAW"},
+		{"Zambales", "ZMB"},
+		{"Zamboanga Sibugay", "ZSI"},
+		{"Zamboanga del Norte", "ZAN"},
+		{"Zamboanga del Sur", "ZAS"},
+	},
+	"PK": {
+		{"Azad Jammu and Kashmir", "JK"},
+		{"Balochistan", "BA"},
+		{"Gilgit-Baltistan", "GB"},
+		{"Islamabad", "IS"},
+		{"Khyber Pakhtunkhwa", "KP"},
+		{"Punjab", "PB"},
+		{"Sindh", "SD"},
+	},
+	"PL": {
+		{"Dolnośląskie", "02"},
+		{"Kujawsko-pomorskie", "04"},
+		{"Lubelskie", "06"},
+		{"Lubuskie", "08"},
+		{"Mazowieckie", "14"},
+		{"Małopolskie", "12"},
+		{"Opolskie", "16"},
+		{"Podkarpackie", "18"},
+		{"Podlaskie", "20"},
+		{"Pomorskie", "22"},
+		{"Warmińsko-mazurskie", "28"},
+		{"Wielkopolskie", "30"},
+		{"Zachodniopomorskie", "32"},
+		{"Łódzkie", "10"},
+		{"Śląskie", "24"},
+		{"Świętokrzyskie", "26"},
+	},
+	"PS": {
+		{"Bethlehem", "BTH"},
+		{"Deir El Balah", "DEB"},
+		{"Gaza", "GZA"},
+		{"Hebron", "HBN"},
+		{"Jenin", "JEN"},
+		{"Jericho and Al Aghwar", "JRH"},
+		{"Jerusalem", "JEM"},
+		{"Khan Yunis", "KYS"},
+		{"Nablus", "NBS"},
+		{"North Gaza", "NGZ"},
+		{"Qalqilya", "QQA"},
+		{"Rafah", "RFH"},
+		{"Ramallah", "RBH"},
+		{"Salfit", "SLT"},
+		{"Tubas", "TBS"},
+		{"Tulkarm", "TKM"},
+	},
+	"PT": {
+		{"Aveiro", "01"},
+		{"Beja", "02"},
+		{"Braga", "03"},
+		{"Bragança", "04"},
+		{"Castelo Branco", "05"},
+		{"Coimbra", "06"},
+		{"Faro", "08"},
+		{"Guarda", "09"},
+		{"Leiria", "10"},
+		{"Lisboa", "11"},
+		{"Portalegre", "12"},
+		{"Porto", "13"},
+		{"Região Autónoma da Madeira", "30"},
+		{"Região Autónoma dos Açores", "20"},
+		{"Santarém", "14"},
+		{"Setúbal", "15"},
+		{"Viana do Castelo", "16"},
+		{"Vila Real", "17"},
+		{"Viseu", "18"},
+		{"Évora", "07"},
+	},
+	"PW": {
+		{"Aimeliik", "002"},
+		{"Airai", "004"},
+		{"Angaur", "010"},
+		{"Hatohobei", "050"},
+		{"Kayangel", "100"},
+		{"Koror", "150"},
+		{"Melekeok", "212"},
+		{"Ngaraard", "214"},
+		{"Ngarchelong", "218"},
+		{"Ngardmau", "222"},
+		{"Ngatpang", "224"},
+		{"Ngchesar", "226"},
+		{"Ngeremlengui", "227"},
+		{"Ngiwal", "228"},
+		{"Peleliu", "350"},
+		{"Sonsorol", "370"},
+	},
+	"PY": {
+		{"Alto Paraguay", "16"},
+		{"Alto Paraná", "10"},
+		{"Amambay", "13"},
+		{"Asunción", "ASU"},
+		{"Boquerón", "19"},
+		{"Caaguazú", "5"},
+		{"Caazapá", "6"},
+		{"Canindeyú", "14"},
+		{"Central", "11"},
+		{"Concepción", "1"},
+		{"Cordillera", "3"},
+		{"Guairá", "4"},
+		{"Itapúa", "7"},
+		{"Misiones", "8"},
+		{"Paraguarí", "9"},
+		{"Presidente Hayes", "15"},
+		{"San Pedro", "2"},
+		{"Ñeembucú", "12"},
+	},
+	"QA": {
+		{"Ad Dawḩah", "DA"},
+		{"Al Khawr wa adh Dhakhīrah", "KH"},
+		{"Al Wakrah", "WA"},
+		{"Ar Rayyān", "RA"},
+		{"Ash Shamāl", "MS"},
+		{"Ash Shīḩānīyah", "SH"},
+		{"Az̧ Z̧a‘āyin", "ZA"},
+		{"Umm Şalāl", "US"},
+	},
+	"RO": {
+		{"Alba", "AB"},
+		{"Arad", "AR"},
+		{"Argeș", "AG"},
+		{"Bacău", "BC"},
+		{"Bihor", "BH"},
+		{"Bistrița-Năsăud", "BN"},
+		{"Botoșani", "BT"},
+		{"Brașov", "BV"},
+		{"Brăila", "BR"},
+		{"București", "B"},
+		{"Buzău", "BZ"},
+		{"Caraș-Severin", "CS"},
+		{"Cluj", "CJ"},
+		{"Constanța", "CT"},
+		{"Covasna", "CV"},
+		{"Călărași", "CL"},
+		{"Dolj", "DJ"},
+		{"Dâmbovița", "DB"},
+		{"Galați", "GL"},
+		{"Giurgiu", "GR"},
+		{"Gorj", "GJ"},
+		{"Harghita", "HR"},
+		{"Hunedoara", "HD"},
+		{"Ialomița", "IL"},
+		{"Iași", "IS"},
+		{"Ilfov", "IF"},
+		{"Maramureș", "MM"},
+		{"Mehedinți", "MH"},
+		{"Mureș", "MS"},
+		{"Neamț", "NT"},
+		{"Olt", "OT"},
+		{"Prahova", "PH"},
+		{"Satu Mare", "SM"},
+		{"Sibiu", "SB"},
+		{"Suceava", "SV"},
+		{"Sălaj", "SJ"},
+		{"Teleorman", "TR"},
+		{"Timiș", "TM"},
+		{"Tulcea", "TL"},
+		{"Vaslui", "VS"},
+		{"Vrancea", "VN"},
+		{"Vâlcea", "VL"},
+	},
+	"RS": {
+		{"Beograd", "00"},
+		{"Borski okrug", "14"},
+		{"Braničevski okrug", "11"},
+		{"Jablanički okrug", "23"},
+		{"Kolubarski okrug", "09"},
+		{"Kosovo-Metohija", "KM"},
+		{"Mačvanski okrug", "08"},
+		{"Moravički okrug", "17"},
+		{"Nišavski okrug", "20"},
+		{"Pirotski okrug", "22"},
+		{"Podunavski okrug", "10"},
+		{"Pomoravski okrug", "13"},
+		{"Pčinjski okrug", "24"},
+		{"Rasinski okrug", "19"},
+		{"Raški okrug", "18"},
+		{"Toplički okrug", "21"},
+		{"Vojvodina", "VO"},
+		{"Zaječarski okrug", "15"},
+		{"Zlatiborski okrug", "16"},
+		{"Šumadijski okrug", "12"},
+		{"Јабланички округ", "23"},
+		{"Београд", "00"},
+		{"Борски округ", "14"},
+		{"Браничевски округ", "11"},
+		{"Војводина", "VO"},
+		{"Зајечарски округ", "15"},
+		{"Златиборски округ", "16"},
+		{"Колубарски округ", "09"},
+		{"Косово и Метохија", "KM"},
+		{"Мачвански округ", "08"},
+		{"Моравички округ", "17"},
+		{"Нишавски округ", "20"},
+		{"Пиротски округ", "22"},
+		{"Подунавски округ", "10"},
+		{"Поморавски округ", "13"},
+		{"Пчињски округ", "24"},
+		{"Расински округ", "19"},
+		{"Рашки округ", "18"},
+		{"Топлички округ", "21"},
+		{"Шумадијски округ", "12"},
+		{"Južnobanatski okrug", "04"},
+		{"Južnobački okrug", "06"},
+		{"Kosovski okrug", "25"},
+		{"Kosovsko-Mitrovački okrug", "28"},
+		{"Kosovsko-Pomoravski okrug", "29"},
+		{"Pećki okrug", "26"},
+		{"Prizrenski okrug", "27"},
+		{"Severnobanatski okrug", "03"},
+		{"Severnobački okrug", "01"},
+		{"Srednjebanatski okrug", "02"},
+		{"Sremski okrug", "07"},
+		{"Zapadnobački okrug", "05"},
+		{"Јужнобанатски округ", "04"},
+		{"Јужнобачки округ", "06"},
+		{"Западнобачки округ", "05"},
+		{"Косовски округ", "25"},
+		{"Косовско-Митровачки округ", "28"},
+		{"Косовско-поморавски округ", "29"},
+		{"Пећки округ", "26"},
+		{"Призренски округ", "27"},
+		{"Севернобанатски округ", "03"},
+		{"Севернобачки округ", "01"},
+		{"Средњебанатски округ", "02"},
+		{"Сремски округ", "07"},
+	},
+	"RU": {
+		{"Adygeja, Respublika", "AD"},
+		{"Altaj, Respublika", "AL"},
+		{"Altajskij kraj", "ALT"},
+		{"Amurskaja oblast'", "AMU"},
+		{"Arhangel'skaja oblast'", "ARK"},
+		{"Astrahanskaja oblast'", "AST"},
+		{"Bashkortostan, Respublika", "BA"},
+		{"Belgorodskaja oblast'", "BEL"},
+		{"Brjanskaja oblast'", "BRY"},
+		{"Burjatija, Respublika", "BU"},
+		{"Chechenskaya Respublika", "CE"},
+		{"Chelyabinskaya oblast'", "CHE"},
+		{"Chukotskiy avtonomnyy okrug", "CHU"},
+		{"Chuvashskaya Respublika", "CU"},
+		{"Dagestan, Respublika", "DA"},
+		{"Evrejskaja avtonomnaja oblast'", "YEV"},
+		{"Habarovskij kraj", "KHA"},
+		{"Hakasija, Respublika", "KK"},
+		{"Hanty-Mansijskij avtonomnyj okrug", "KHM"},
+		{"Ingushetiya, Respublika", "IN"},
+		{"Irkutskaja oblast'", "IRK"},
+		{"Ivanovskaja oblast'", "IVA"},
+		{"Jamalo-Neneckij avtonomnyj okrug", "YAN"},
+		{"Jaroslavskaja oblast'", "YAR"},
+		{"Kabardino-Balkarskaja Respublika", "KB"},
+		{"Kaliningradskaja oblast'", "KGD"},
+		{"Kalmykija, Respublika", "KL"},
+		{"Kaluzhskaya oblast'", "KLU"},
+		{"Kamchatskiy kray", "KAM"},
+		{"Karachayevo-Cherkesskaya Respublika", "KC"},
+		{"Karelija, Respublika", "KR"},
+		{"Kemerovskaja oblast'", "KEM"},
+		{"Kirovskaja oblast'", "KIR"},
+		{"Komi, Respublika", "KO"},
+		{"Kostromskaja oblast'", "KOS"},
+		{"Krasnodarskij kraj", "KDA"},
+		{"Krasnojarskij kraj", "KYA"},
+		{"Kurganskaja oblast'", "KGN"},
+		{"Kurskaja oblast'", "KRS"},
+		{"Leningradskaja oblast'", "LEN"},
+		{"Lipeckaja oblast'", "LIP"},
+		{"Magadanskaja oblast'", "MAG"},
+		{"Marij Èl, Respublika", "ME"},
+		{"Mordovija, Respublika", "MO"},
+		{"Moskovskaja oblast'", "MOS"},
+		{"Moskva", "MOW"},
+		{"Murmanskaja oblast'", "MUR"},
+		{"Neneckij avtonomnyj okrug", "NEN"},
+		{"Nizhegorodskaya oblast'", "NIZ"},
+		{"Novgorodskaja oblast'", "NGR"},
+		{"Novosibirskaja oblast'", "NVS"},
+		{"Omskaja oblast'", "OMS"},
+		{"Orenburgskaja oblast'", "ORE"},
+		{"Orlovskaja oblast'", "ORL"},
+		{"Penzenskaja oblast'", "PNZ"},
+		{"Permskij kraj", "PER"},
+		{"Primorskij kraj", "PRI"},
+		{"Pskovskaja oblast'", "PSK"},
+		{"Rjazanskaja oblast'", "RYA"},
+		{"Rostovskaja oblast'", "ROS"},
+		{"Saha, Respublika", "SA"},
+		{"Sahalinskaja oblast'", "SAK"},
+		{"Samarskaja oblast'", "SAM"},
+		{"Sankt-Peterburg", "SPE"},
+		{"Saratovskaja oblast'", "SAR"},
+		{"Severnaja Osetija, Respublika", "SE"},
+		{"Smolenskaja oblast'", "SMO"},
+		{"Stavropol'skij kraj", "STA"},
+		{"Sverdlovskaja oblast'", "SVE"},
+		{"Tambovskaja oblast'", "TAM"},
+		{"Tatarstan, Respublika", "TA"},
+		{"Tjumenskaja oblast'", "TYU"},
+		{"Tomskaja oblast'", "TOM"},
+		{"Tul'skaja oblast'", "TUL"},
+		{"Tverskaja oblast'", "TVE"},
+		{"Tyva, Respublika", "TY"},
+		{"Udmurtskaja Respublika", "UD"},
+		{"Ul'janovskaja oblast'", "ULY"},
+		{"Vladimirskaja oblast'", "VLA"},
+		{"Volgogradskaja oblast'", "VGG"},
+		{"Vologodskaja oblast'", "VLG"},
+		{"Voronezhskaya oblast'", "VOR"},
+		{"Zabajkal'skij kraj", "ZAB"},
+		{"Adygeja", "AD"},
+		{"Altaj", "AL"},
+		{"Bashkortostan", "BA"},
+		{"Burjatija", "BU"},
+		{"Dagestan", "DA"},
+		{"Hakasija", "KK"},
+		{"Ingushetiya", "IN"},
+		{"Kalmykija", "KL"},
+		{"Karelija", "KR"},
+		{"Komi", "KO"},
+		{"Marij Èl", "ME"},
+		{"Mordovija", "MO"},
+		{"Respublika Adygeja", "AD"},
+		{"Respublika Altaj", "AL"},
+		{"Respublika Bashkortostan", "BA"},
+		{"Respublika Burjatija", "BU"},
+		{"Respublika Dagestan", "DA"},
+		{"Respublika Hakasija", "KK"},
+		{"Respublika Ingushetiya", "IN"},
+		{"Respublika Kalmykija", "KL"},
+		{"Respublika Karelija", "KR"},
+		{"Respublika Komi", "KO"},
+		{"Respublika Marij Èl", "ME"},
+		{"Respublika Mordovija", "MO"},
+		{"Respublika Saha", "SA"},
+		{"Respublika Severnaja Osetija", "SE"},
+		{"Respublika Tatarstan", "TA"},
+		{"Respublika Tyva", "TY"},
+		{"Saha", "SA"},
+		{"Severnaja Osetija", "SE"},
+		{"Tatarstan", "TA"},
+		{"Tyva", "TY"},
+		{"Алтайский край", "ALT"},
+		{"Амурская область", "AMU"},
+		{"Архангельская область", "ARK"},
+		{"Астраханская область", "AST"},
+		{"Белгородская область", "BEL"},
+		{"Брянская область", "BRY"},
+		{"Владимирская область", "VLA"},
+		{"Волгоградская область", "VGG"},
+		{"Вологодская область", "VLG"},
+		{"Воронежская область", "VOR"},
+		{"Еврейская автономная область", "YEV"},
+		{"Забайкальский край", "ZAB"},
+		{"Ивановская область", "IVA"},
+		{"Иркутская область", "IRK"},
+		{"Кабардино-Балкарская республика", "KB"},
+		{"Калининградская область", "KGD"},
+		{"Калужская область", "KLU"},
+		{"Камчатский край", "KAM"},
+		{"Карачаево-Черкесская республика", "KC"},
+		{"Кемеровская область", "KEM"},
+		{"Кировская область", "KIR"},
+		{"Костромская область", "KOS"},
+		{"Краснодарский край", "KDA"},
+		{"Красноярский край", "KYA"},
+		{"Курганская область", "KGN"},
+		{"Курская область", "KRS"},
+		{"Ленинградская область", "LEN"},
+		{"Липецкая область", "LIP"},
+		{"Магаданская область", "MAG"},
+		{"Москва", "MOW"},
+		{"Московская область", "MOS"},
+		{"Мурманская область", "MUR"},
+		{"Ненецкий автономный округ", "NEN"},
+		{"Нижегородская область", "NIZ"},
+		{"Новгородская область", "NGR"},
+		{"Новосибирская область", "NVS"},
+		{"Омская область", "OMS"},
+		{"Оренбургская область", "ORE"},
+		{"Орловская область", "ORL"},
+		{"Пензенская область", "PNZ"},
+		{"Пермский край", "PER"},
+		{"Приморский край", "PRI"},
+		{"Псковская область", "PSK"},
+		{"Республика Адыгея", "AD"},
+		{"Республика Алтай", "AL"},
+		{"Республика Башкортостан", "BA"},
+		{"Республика Бурятия", "BU"},
+		{"Республика Дагестан", "DA"},
+		{"Республика Ингушетия", "IN"},
+		{"Республика Калмыкия", "KL"},
+		{"Республика Карелия", "KR"},
+		{"Республика Коми", "KO"},
+		{"Республика Марий Эл", "ME"},
+		{"Республика Мордовия", "MO"},
+		{"Республика Саха", "SA"},
+		{"Республика Северная Осетия", "SE"},
+		{"Республика Татарстан", "TA"},
+		{"Республика Тува", "TY"},
+		{"Республика Удмуртия", "UD"},
+		{"Республика Хакассия", "KK"},
+		{"Ростовская область", "ROS"},
+		{"Рязанская область", "RYA"},
+		{"Самарская область", "SAM"},
+		{"Санкт-Петербург", "SPE"},
+		{"Саратовская область", "SAR"},
+		{"Сахалинская область", "SAK"},
+		{"Свердловская область", "SVE"},
+		{"Смоленская область", "SMO"},
+		{"Ставропольский край", "STA"},
+		{"Тамбовская область", "TAM"},
+		{"Тверская область", "TVE"},
+		{"Томская область", "TOM"},
+		{"Тульская область", "TUL"},
+		{"Тюменская область", "TYU"},
+		{"Ульяновская область", "ULY"},
+		{"Хабаровский край", "KHA"},
+		{"Ханты-Мансийский автономный округ", "KHM"},
+		{"Челябинская область", "CHE"},
+		{"Чеченская республика", "CE"},
+		{"Чувашская республика", "CU"},
+		{"Чукотский автономный округ", "CHU"},
+		{"Ямало-Ненецкий автономный округ", "YAN"},
+		{"Ярославская область", "YAR"},
+	},
+	"RW": {
+		{"City of Kigali", "01"},
+		{"Eastern", "02"},
+		{"Northern", "03"},
+		{"Southern", "05"},
+		{"Western", "04"},
+	},
+	"SA": {
+		{"'Asīr", "14"},
+		{"Al Bāḩah", "11"},
+		{"Al Jawf", "12"},
+		{"Al Madīnah al Munawwarah", "03"},
+		{"Al Qaşīm", "05"},
+		{"Al Ḩudūd ash Shamālīyah", "08"},
+		{"Ar Riyāḑ", "01"},
+		{"Ash Sharqīyah", "04"},
+		{"Jāzān", "09"},
+		{"Makkah al Mukarramah", "02"},
+		{"Najrān", "10"},
+		{"Tabūk", "07"},
+		{"Ḩā'il", "06"},
+	},
+	"SB": {
+		{"Capital Territory (Honiara)", "CT"},
+		{"Central", "CE"},
+		{"Choiseul", "CH"},
+		{"Guadalcanal", "GU"},
+		{"Isabel", "IS"},
+		{"Makira-Ulawa", "MK"},
+		{"Malaita", "ML"},
+		{"Rennell and Bellona", "RB"},
+		{"Temotu", "TE"},
+		{"Western", "WE"},
+		{"Capital Territory", "CT"},
+	},
+	"SC": {
+		{"Anse Boileau", "02"},
+		{"Anse Etoile", "03"},
+		{"Anse Royale", "05"},
+		{"Anse aux Pins", "01"},
+		{"Au Cap", "04"},
+		{"Baie Lazare", "06"},
+		{"Baie Sainte Anne", "07"},
+		{"Beau Vallon", "08"},
+		{"Bel Air", "09"},
+		{"Bel Ombre", "10"},
+		{"Cascade", "11"},
+		{"English River", "16"},
+		{"Glacis", "12"},
+		{"Grand Anse Mahe", "13"},
+		{"Grand Anse Praslin", "14"},
+		{"Ile Perseverance I", "26"},
+		{"Ile Perseverance II", "27"},
+		{"La Digue", "15"},
+		{"Les Mamelles", "24"},
+		{"Mont Buxton", "17"},
+		{"Mont Fleuri", "18"},
+		{"Plaisance", "19"},
+		{"Pointe Larue", "20"},
+		{"Port Glaud", "21"},
+		{"Roche Caiman", "25"},
+		{"Saint Louis", "22"},
+		{"Takamaka", "23"},
+	},
+	"SD": {
+		{"Blue Nile", "NB"},
+		{"Central Darfur", "DC"},
+		{"East Darfur", "DE"},
+		{"Gedaref", "GD"},
+		{"Gezira", "GZ"},
+		{"Kassala", "KA"},
+		{"Khartoum", "KH"},
+		{"North Darfur", "DN"},
+		{"North Kordofan", "KN"},
+		{"Northern", "NO"},
+		{"Red Sea", "RS"},
+		{"River Nile", "NR"},
+		{"Sennar", "SI"},
+		{"South Darfur", "DS"},
+		{"South Kordofan", "KS"},
+		{"West Darfur", "DW"},
+		{"West Kordofan", "GK"},
+		{"White Nile", "NW"},
+	},
+	"SE": {
+		{"Blekinge län", "K"},
+		{"Dalarnas län", "W"},
+		{"Gotlands län", "I"},
+		{"Gävleborgs län", "X"},
+		{"Hallands län", "N"},
+		{"Jämtlands län", "Z"},
+		{"Jönköpings län", "F"},
+		{"Kalmar län", "H"},
+		{"Kronobergs län", "G"},
+		{"Norrbottens län", "BD"},
+		{"Skåne län", "M"},
+		{"Stockholms län", "AB"},
+		{"Södermanlands län", "D"},
+		{"Uppsala län", "C"},
+		{"Värmlands län", "S"},
+		{"Västerbottens län", "AC"},
+		{"Västernorrlands län", "Y"},
+		{"Västmanlands län", "U"},
+		{"Västra Götalands län", "O"},
+		{"Örebro län", "T"},
+		{"Östergötlands län", "E"},
+		{"SE-01", "AB"},
+		{"SE-03", "C"},
+		{"SE-04", "D"},
+		{"SE-05", "E"},
+		{"SE-06", "F"},
+		{"SE-07", "G"},
+		{"SE-08", "H"},
+		{"SE-09", "I"},
+		{"SE-10", "K"},
+		{"SE-12", "M"},
+		{"SE-13", "N"},
+		{"SE-14", "O"},
+		{"SE-17", "S"},
+		{"SE-18", "T"},
+		{"SE-19", "U"},
+		{"SE-20", "W"},
+		{"SE-21", "X"},
+		{"SE-22", "Y"},
+		{"SE-23", "Z"},
+		{"SE-24", "AC"},
+		{"SE-25", "BD"},
+	},
+	"SG": {
+		{"Central Singapore", "01"},
+		{"North East", "02"},
+		{"North West", "03"},
+		{"South East", "04"},
+		{"South West", "05"},
+	},
+	"SH": {
+		{"Ascension", "AC"},
+		{"Saint Helena", "HL"},
+		{"Tristan da Cunha", "TA"},
+	},
+	"SI": {
+		{"Ajdovščina", "001"},
+		{"Ankaran", "213"},
+		{"Apače", "195"},
+		{"Beltinci", "002"},
+		{"Benedikt", "148"},
+		{"Bistrica ob Sotli", "149"},
+		{"Bled", "003"},
+		{"Bloke", "150"},
+		{"Bohinj", "004"},
+		{"Borovnica", "005"},
+		{"Bovec", "006"},
+		{"Braslovče", "151"},
+		{"Brda", "007"},
+		{"Brezovica", "008"},
+		{"Brežice", "009"},
+		{"Cankova", "152"},
+		{"Celje", "011"},
+		{"Cerklje na Gorenjskem", "012"},
+		{"Cerknica", "013"},
+		{"Cerkno", "014"},
+		{"Cerkvenjak", "153"},
+		{"Cirkulane", "196"},
+		{"Destrnik", "018"},
+		{"Divača", "019"},
+		{"Dobje", "154"},
+		{"Dobrepolje", "020"},
+		{"Dobrna", "155"},
+		{"Dobrova-Polhov Gradec", "021"},
+		{"Dobrovnik", "156"},
+		{"Dol pri Ljubljani", "022"},
+		{"Dolenjske Toplice", "157"},
+		{"Domžale", "023"},
+		{"Dornava", "024"},
+		{"Dravograd", "025"},
+		{"Duplek", "026"},
+		{"Gorenja vas-Poljane", "027"},
+		{"Gorišnica", "028"},
+		{"Gorje", "207"},
+		{"Gornja Radgona", "029"},
+		{"Gornji Grad", "030"},
+		{"Gornji Petrovci", "031"},
+		{"Grad", "158"},
+		{"Grosuplje", "032"},
+		{"Hajdina", "159"},
+		{"Hodoš", "161"},
+		{"Horjul", "162"},
+		{"Hoče-Slivnica", "160"},
+		{"Hrastnik", "034"},
+		{"Hrpelje-Kozina", "035"},
+		{"Idrija", "036"},
+		{"Ig", "037"},
+		{"Ilirska Bistrica", "038"},
+		{"Ivančna Gorica", "039"},
+		{"Izola", "040"},
+		{"Jesenice", "041"},
+		{"Jezersko", "163"},
+		{"Juršinci", "042"},
+		{"Kamnik", "043"},
+		{"Kanal", "044"},
+		{"Kidričevo", "045"},
+		{"Kobarid", "046"},
+		{"Kobilje", "047"},
+		{"Komen", "049"},
+		{"Komenda", "164"},
+		{"Koper", "050"},
+		{"Kosanjevica na Krki", "197"},
+		{"Kostel", "165"},
+		{"Kozje", "051"},
+		{"Kočevje", "048"},
+		{"Kranj", "052"},
+		{"Kranjska Gora", "053"},
+		{"Križevci", "166"},
+		{"Krško", "054"},
+		{"Kungota", "055"},
+		{"Kuzma", "056"},
+		{"Laško", "057"},
+		{"Lenart", "058"},
+		{"Lendava", "059"},
+		{"Litija", "060"},
+		{"Ljubljana", "061"},
+		{"Ljubno", "062"},
+		{"Ljutomer", "063"},
+		{"Log-Dragomer", "208"},
+		{"Logatec", "064"},
+		{"Lovrenc na Pohorju", "167"},
+		{"Loška dolina", "065"},
+		{"Loški Potok", "066"},
+		{"Lukovica", "068"},
+		{"Luče", "067"},
+		{"Majšperk", "069"},
+		{"Makole", "198"},
+		{"Maribor", "070"},
+		{"Markovci", "168"},
+		{"Medvode", "071"},
+		{"Mengeš", "072"},
+		{"Metlika", "073"},
+		{"Mežica", "074"},
+		{"Miklavž na Dravskem polju", "169"},
+		{"Miren-Kostanjevica", "075"},
+		{"Mirna", "212"},
+		{"Mirna Peč", "170"},
+		{"Mislinja", "076"},
+		{"Mokronog-Trebelno", "199"},
+		{"Moravske Toplice", "078"},
+		{"Moravče", "077"},
+		{"Mozirje", "079"},
+		{"Murska Sobota", "080"},
+		{"Muta", "081"},
+		{"Naklo", "082"},
+		{"Nazarje", "083"},
+		{"Nova Gorica", "084"},
+		{"Novo Mesto", "085"},
+		{"Odranci", "086"},
+		{"Oplotnica", "171"},
+		{"Ormož", "087"},
+		{"Osilnica", "088"},
+		{"Pesnica", "089"},
+		{"Piran", "090"},
+		{"Pivka", "091"},
+		{"Podlehnik", "172"},
+		{"Podvelka", "093"},
+		{"Podčetrtek", "092"},
+		{"Poljčane", "200"},
+		{"Polzela", "173"},
+		{"Postojna", "094"},
+		{"Prebold", "174"},
+		{"Preddvor", "095"},
+		{"Prevalje", "175"},
+		{"Ptuj", "096"},
+		{"Puconci", "097"},
+		{"Radenci", "100"},
+		{"Radeče", "099"},
+		{"Radlje ob Dravi", "101"},
+		{"Radovljica", "102"},
+		{"Ravne na Koroškem", "103"},
+		{"Razkrižje", "176"},
+		{"Rače-Fram", "098"},
+		{"Renče-Vogrsko", "201"},
+		{"Rečica ob Savinji", "209"},
+		{"Ribnica", "104"},
+		{"Ribnica na Pohorju", "177"},
+		{"Rogatec", "107"},
+		{"Rogaška Slatina", "106"},
+		{"Rogašovci", "105"},
+		{"Ruše", "108"},
+		{"Selnica ob Dravi", "178"},
+		{"Semič", "109"},
+		{"Sevnica", "110"},
+		{"Sežana", "111"},
+		{"Slovenj Gradec", "112"},
+		{"Slovenska Bistrica", "113"},
+		{"Slovenske Konjice", "114"},
+		{"Sodražica", "179"},
+		{"Solčava", "180"},
+		{"Središče ob Dravi", "202"},
+		{"Starše", "115"},
+		{"Straža", "203"},
+		{"Sveta Ana", "181"},
+		{"Sveta Trojica v Slovenskih goricah", "204"},
+		{"Sveti Andraž v Slovenskih goricah", "182"},
+		{"Sveti Jurij ob Ščavnici", "116"},
+		{"Sveti Jurij v Slovenskih goricah", "210"},
+		{"Sveti Tomaž", "205"},
+		{"Tabor", "184"},
+		{"Tišina", "010"},
+		{"Tolmin", "128"},
+		{"Trbovlje", "129"},
+		{"Trebnje", "130"},
+		{"Trnovska Vas", "185"},
+		{"Trzin", "186"},
+		{"Tržič", "131"},
+		{"Turnišče", "132"},
+		{"Velenje", "133"},
+		{"Velika Polana", "187"},
+		{"Velike Lašče", "134"},
+		{"Veržej", "188"},
+		{"Videm", "135"},
+		{"Vipava", "136"},
+		{"Vitanje", "137"},
+		{"Vodice", "138"},
+		{"Vojnik", "139"},
+		{"Vransko", "189"},
+		{"Vrhnika", "140"},
+		{"Vuzenica", "141"},
+		{"Zagorje ob Savi", "142"},
+		{"Zavrč", "143"},
+		{"Zreče", "144"},
+		{"Črenšovci", "015"},
+		{"Črna na Koroškem", "016"},
+		{"Črnomelj", "017"},
+		{"Šalovci", "033"},
+		{"Šempeter-Vrtojba", "183"},
+		{"Šentilj", "118"},
+		{"Šentjernej", "119"},
+		{"Šentjur", "120"},
+		{"Šentrupert", "211"},
+		{"Šenčur", "117"},
+		{"Škocjan", "121"},
+		{"Škofja Loka", "122"},
+		{"Škofljica", "123"},
+		{"Šmarje pri Jelšah", "124"},
+		{"Šmarješke Toplice", "206"},
+		{"Šmartno ob Paki", "125"},
+		{"Šmartno pri Litiji", "194"},
+		{"Šoštanj", "126"},
+		{"Štore", "127"},
+		{"Žalec", "190"},
+		{"Železniki", "146"},
+		{"Žetale", "191"},
+		{"Žiri", "147"},
+		{"Žirovnica", "192"},
+		{"Žužemberk", "193"},
+	},
+	"SK": {
+		{"Banskobystrický kraj", "BC"},
+		{"Bratislavský kraj", "BL"},
+		{"Košický kraj", "KI"},
+		{"Nitriansky kraj", "NI"},
+		{"Prešovský kraj", "PV"},
+		{"Trenčiansky kraj", "TC"},
+		{"Trnavský kraj", "TA"},
+		{"Žilinský kraj", "ZI"},
+	},
+	"SL": {
+		{"Eastern", "E"},
+		{"North Western", "NW"},
+		{"Northern", "N"},
+		{"Southern", "S"},
+		{"Western Area (Freetown)", "W"},
+		{"Western Area", "W"},
+	},
+	"SM": {
+		{"Acquaviva", "01"},
+		{"Borgo Maggiore", "06"},
+		{"Chiesanuova", "02"},
+		{"Città di San Marino", "07"},
+		{"Domagnano", "03"},
+		{"Faetano", "04"},
+		{"Fiorentino", "05"},
+		{"Montegiardino", "08"},
+		{"Serravalle", "09"},
+	},
+	"SN": {
+		{"Dakar", "DK"},
+		{"Diourbel", "DB"},
+		{"Fatick", "FK"},
+		{"Kaffrine", "KA"},
+		{"Kaolack", "KL"},
+		{"Kolda", "KD"},
+		{"Kédougou", "KE"},
+		{"Louga", "LG"},
+		{"Matam", "MT"},
+		{"Saint-Louis", "SL"},
+		{"Sédhiou", "SE"},
+		{"Tambacounda", "TC"},
+		{"Thiès", "TH"},
+		{"Ziguinchor", "ZG"},
+	},
+	"SO": {
+		{"Awdal", "AW"},
+		{"Bakool", "BK"},
+		{"Banaadir", "BN"},
+		{"Bari", "BR"},
+		{"Bay", "BY"},
+		{"Galguduud", "GA"},
+		{"Gedo", "GE"},
+		{"Hiiraan", "HI"},
+		{"Jubbada Dhexe", "JD"},
+		{"Jubbada Hoose", "JH"},
+		{"Mudug", "MU"},
+		{"Nugaal", "NU"},
+		{"Sanaag", "SA"},
+		{"Shabeellaha Dhexe", "SD"},
+		{"Shabeellaha Hoose", "SH"},
+		{"Sool", "SO"},
+		{"Togdheer", "TO"},
+		{"Woqooyi Galbeed", "WO"},
+	},
+	"SR": {
+		{"Brokopondo", "BR"},
+		{"Commewijne", "CM"},
+		{"Coronie", "CR"},
+		{"Marowijne", "MA"},
+		{"Nickerie", "NI"},
+		{"Para", "PR"},
+		{"Paramaribo", "PM"},
+		{"Saramacca", "SA"},
+		{"Sipaliwini", "SI"},
+		{"Wanica", "WA"},
+	},
+	"SS": {
+		{"Central Equatoria", "EC"},
+		{"Eastern Equatoria", "EE"},
+		{"Jonglei", "JG"},
+		{"Lakes", "LK"},
+		{"Northern Bahr el Ghazal", "BN"},
+		{"Unity", "UY"},
+		{"Upper Nile", "NU"},
+		{"Warrap", "WR"},
+		{"Western Bahr el Ghazal", "BW"},
+		{"Western Equatoria", "EW"},
+	},
+	"ST": {
+		{"Cantagalo", "02"},
+		{"Caué", "03"},
+		{"Lembá", "04"},
+		{"Lobata", "05"},
+		{"Mé-Zóchi", "06"},
+		{"Príncipe", "P"},
+		{"Água Grande", "01"},
+	},
+	"SV": {
+		{"Ahuachapán", "AH"},
+		{"Cabañas", "CA"},
+		{"Chalatenango", "CH"},
+		{"Cuscatlán", "CU"},
+		{"La Libertad", "LI"},
+		{"La Paz", "PA"},
+		{"La Unión", "UN"},
+		{"Morazán", "MO"},
+		{"San Miguel", "SM"},
+		{"San Salvador", "SS"},
+		{"San Vicente", "SV"},
+		{"Santa Ana", "SA"},
+		{"Sonsonate", "SO"},
+		{"Usulután", "US"},
+	},
+	"SY": {
+		{"Al Lādhiqīyah", "LA"},
+		{"Al Qunayţirah", "QU"},
+		{"Al Ḩasakah", "HA"},
+		{"Ar Raqqah", "RA"},
+		{"As Suwaydā'", "SU"},
+		{"Dar'ā", "DR"},
+		{"Dayr az Zawr", "DY"},
+		{"Dimashq", "DI"},
+		{"Idlib", "ID"},
+		{"Rīf Dimashq", "RD"},
+		{"Ţarţūs", "TA"},
+		{"Ḩalab", "HL"},
+		{"Ḩamāh", "HM"},
+		{"Ḩimş", "HI"},
+	},
+	"SZ": {
+		{"Hhohho", "HH"},
+		{"Lubombo", "LU"},
+		{"Manzini", "MA"},
+		{"Shiselweni", "SH"},
+	},
+	"TD": {
+		{"Al Baţḩā’", "BA"},
+		{"Al Buḩayrah", "LC"},
+		{"Bahr el Ghazal", "BG"},
+		{"Borkou", "BO"},
+		{"Chari-Baguirmi", "CB"},
+		{"Ennedi-Est", "EE"},
+		{"Ennedi-Ouest", "EO"},
+		{"Guéra", "GR"},
+		{"Hadjer Lamis", "HL"},
+		{"Kanem", "KA"},
+		{"Logone-Occidental", "LO"},
+		{"Logone-Oriental", "LR"},
+		{"Madīnat Injamīnā", "ND"},
+		{"Mandoul", "MA"},
+		{"Mayo-Kebbi-Est", "ME"},
+		{"Mayo-Kebbi-Ouest", "MO"},
+		{"Moyen-Chari", "MC"},
+		{"Ouaddaï", "OD"},
+		{"Salamat", "SA"},
+		{"Sila", "SI"},
+		{"Tandjilé", "TA"},
+		{"Tibastī", "TI"},
+		{"Wadi Fira", "WF"},
+	},
+	"TG": {
+		{"Centrale", "C"},
+		{"Kara", "K"},
+		{"Maritime (Région)", "M"},
+		{"Plateaux", "P"},
+		{"Savanes", "S"},
+		{"Maritime", "M"},
+	},
+	"TH": {
+		{"Amnat Charoen", "37"},
+		{"Ang Thong", "15"},
+		{"Bueng Kan", "38"},
+		{"Buri Ram", "31"},
+		{"Chachoengsao", "24"},
+		{"Chai Nat", "18"},
+		{"Chaiyaphum", "36"},
+		{"Chanthaburi", "22"},
+		{"Chiang Mai", "50"},
+		{"Chiang Rai", "57"},
+		{"Chon Buri", "20"},
+		{"Chumphon", "86"},
+		{"Kalasin", "46"},
+		{"Kamphaeng Phet", "62"},
+		{"Kanchanaburi", "71"},
+		{"Khon Kaen", "40"},
+		{"Krabi", "81"},
+		{"Krung Thep Maha Nakhon", "10"},
+		{"Lampang", "52"},
+		{"Lamphun", "51"},
+		{"Loei", "42"},
+		{"Lop Buri", "16"},
+		{"Mae Hong Son", "58"},
+		{"Maha Sarakham", "44"},
+		{"Mukdahan", "49"},
+		{"Nakhon Nayok", "26"},
+		{"Nakhon Pathom", "73"},
+		{"Nakhon Phanom", "48"},
+		{"Nakhon Ratchasima", "30"},
+		{"Nakhon Sawan", "60"},
+		{"Nakhon Si Thammarat", "80"},
+		{"Nan", "55"},
+		{"Narathiwat", "96"},
+		{"Nong Bua Lam Phu", "39"},
+		{"Nong Khai", "43"},
+		{"Nonthaburi", "12"},
+		{"Pathum Thani", "13"},
+		{"Pattani", "94"},
+		{"Phangnga", "82"},
+		{"Phatthalung", "93"},
+		{"Phatthaya", "S"},
+		{"Phayao", "56"},
+		{"Phetchabun", "67"},
+		{"Phetchaburi", "76"},
+		{"Phichit", "66"},
+		{"Phitsanulok", "65"},
+		{"Phra Nakhon Si Ayutthaya", "14"},
+		{"Phrae", "54"},
+		{"Phuket", "83"},
+		{"Prachin Buri", "25"},
+		{"Prachuap Khiri Khan", "77"},
+		{"Ranong", "85"},
+		{"Ratchaburi", "70"},
+		{"Rayong", "21"},
+		{"Roi Et", "45"},
+		{"Sa Kaeo", "27"},
+		{"Sakon Nakhon", "47"},
+		{"Samut Prakan", "11"},
+		{"Samut Sakhon", "74"},
+		{"Samut Songkhram", "75"},
+		{"Saraburi", "19"},
+		{"Satun", "91"},
+		{"Si Sa Ket", "33"},
+		{"Sing Buri", "17"},
+		{"Songkhla", "90"},
+		{"Sukhothai", "64"},
+		{"Suphan Buri", "72"},
+		{"Surat Thani", "84"},
+		{"Surin", "32"},
+		{"Tak", "63"},
+		{"Trang", "92"},
+		{"Trat", "23"},
+		{"Ubon Ratchathani", "34"},
+		{"Udon Thani", "41"},
+		{"Uthai Thani", "61"},
+		{"Uttaradit", "53"},
+		{"Yala", "95"},
+		{"Yasothon", "35"},
+		{"กระบี่", "81"},
+		{"กาญจนบุรี", "71"},
+		{"กาฬสินธุ์", "46"},
+		{"กำแพงเพชร", "62"},
+		{"ขอนแก่น", "40"},
+		{"จันทบุรี", "22"},
+		{"ฉะเชิงเทรา", "24"},
+		{"ชลบุรี", "20"},
+		{"ชัยนาท", "18"},
+		{"ชัยภูมิ", "36"},
+		{"ชุมพร", "86"},
+		{"ตรัง", "92"},
+		{"ตราด", "23"},
+		{"ตาก", "63"},
+		{"นครนายก", "26"},
+		{"นครปฐม", "73"},
+		{"นครพนม", "48"},
+		{"นครราชสีมา", "30"},
+		{"นครศรีธรรมราช", "80"},
+		{"นครสวรรค์", "60"},
+		{"นนทบุรี", "12"},
+		{"นราธิวาส", "96"},
+		{"น่าน", "55"},
+		{"บุรีรัมย์", "31"},
+		{"ปทุมธานี", "13"},
+		{"ประจวบคีรีขันธ์", "77"},
+		{"ปราจีนบุรี", "25"},
+		{"ปัตตานี", "94"},
+		{"พระนครศรีอยุธยา", "14"},
+		{"พะเยา", "56"},
+		{"พังงา", "82"},
+		{"พัทยา", "S"},
+		{"พัทลุง", "93"},
+		{"พิจิตร", "66"},
+		{"พิษณุโลก", "65"},
+		{"ภูเก็ต", "83"},
+		{"มหาสารคาม", "44"},
+		{"มุกดาหาร", "49"},
+		{"ยะลา", "95"},
+		{"ยโสธร", "35"},
+		{"ระนอง", "85"},
+		{"ระยอง", "21"},
+		{"ราชบุรี", "70"},
+		{"ร้อยเอ็ด", "45"},
+		{"ลพบุรี", "16"},
+		{"ลำปาง", "52"},
+		{"ลำพูน", "51"},
+		{"ศรีสะเกษ", "33"},
+		{"สกลนคร", "47"},
+		{"สงขลา", "90"},
+		{"สตูล", "91"},
+		{"สมุทรปราการ", "11"},
+		{"สมุทรสงคราม", "75"},
+		{"สมุทรสาคร", "74"},
+		{"สระบุรี", "19"},
+		{"สระแก้ว", "27"},
+		{"สิงห์บุรี", "17"},
+		{"สุพรรณบุรี", "72"},
+		{"สุราษฎร์ธานี", "84"},
+		{"สุรินทร์", "32"},
+		{"สุโขทัย", "64"},
+		{"หนองคาย", "43"},
+		{"หนองบัวลำพู", "39"},
+		{"อำนาจเจริญ", "37"},
+		{"อุดรธานี", "41"},
+		{"อุตรดิตถ์", "53"},
+		{"อุทัยธานี", "61"},
+		{"อุบลราชธานี", "34"},
+		{"อ่างทอง", "15"},
+		{"เชียงราย", "57"},
+		{"เชียงใหม่", "50"},
+		{"เพชรบุรี", "76"},
+		{"เพชรบูรณ์", "67"},
+		{"เลย", "42"},
+		{"แพร่", "54"},
+		{"แม่ฮ่องสอน", "58"},
+	},
+	"TJ": {
+		{"Dushanbe", "DU"},
+		{"Khatlon", "KT"},
+		{"Kŭhistoni Badakhshon", "GB"},
+		{"Sughd", "SU"},
+		{"nohiyahoi tobei jumhurí", "RA"},
+	},
+	"TL": {
+		{"Aileu", "AL"},
+		{"Ainaro", "AN"},
+		{"Baucau", "BA"},
+		{"Bobonaro", "BO"},
+		{"Cova Lima", "CO"},
+		{"Díli", "DI"},
+		{"Ermera", "ER"},
+		{"Lautein", "LA"},
+		{"Likisá", "LI"},
+		{"Manatuto", "MT"},
+		{"Manufahi", "MF"},
+		{"Oekusi-Ambenu", "OE"},
+		{"Vikeke", "VI"},
+	},
+	"TM": {
+		{"Ahal", "A"},
+		{"Aşgabat", "S"},
+		{"Balkan", "B"},
+		{"Daşoguz", "D"},
+		{"Lebap", "L"},
+		{"Mary", "M"},
+	},
+	"TN": {
+		{"Ben Arous", "13"},
+		{"Bizerte", "23"},
+		{"Béja", "31"},
+		{"Gabès", "81"},
+		{"Gafsa", "71"},
+		{"Jendouba", "32"},
+		{"Kairouan", "41"},
+		{"Kasserine", "42"},
+		{"Kébili", "73"},
+		{"L'Ariana", "12"},
+		{"La Manouba", "14"},
+		{"Le Kef", "33"},
+		{"Mahdia", "53"},
+		{"Monastir", "52"},
+		{"Médenine", "82"},
+		{"Nabeul", "21"},
+		{"Sfax", "61"},
+		{"Sidi Bouzid", "43"},
+		{"Siliana", "34"},
+		{"Sousse", "51"},
+		{"Tataouine", "83"},
+		{"Tozeur", "72"},
+		{"Tunis", "11"},
+		{"Zaghouan", "22"},
+	},
+	"TO": {
+		{"'Eua", "01"},
+		{"Ha'apai", "02"},
+		{"Niuas", "03"},
+		{"Tongatapu", "04"},
+		{"Vava'u", "05"},
+	},
+	"TR": {
+		{"Adana", "01"},
+		{"Adıyaman", "02"},
+		{"Afyonkarahisar", "03"},
+		{"Aksaray", "68"},
+		{"Amasya", "05"},
+		{"Ankara", "06"},
+		{"Antalya", "07"},
+		{"Ardahan", "75"},
+		{"Artvin", "08"},
+		{"Aydın", "09"},
+		{"Ağrı", "04"},
+		{"Balıkesir", "10"},
+		{"Bartın", "74"},
+		{"Batman", "72"},
+		{"Bayburt", "69"},
+		{"Bilecik", "11"},
+		{"Bingöl", "12"},
+		{"Bitlis", "13"},
+		{"Bolu", "14"},
+		{"Burdur", "15"},
+		{"Bursa", "16"},
+		{"Denizli", "20"},
+		{"Diyarbakır", "21"},
+		{"Düzce", "81"},
+		{"Edirne", "22"},
+		{"Elazığ", "23"},
+		{"Erzincan", "24"},
+		{"Erzurum", "25"},
+		{"Eskişehir", "26"},
+		{"Gaziantep", "27"},
+		{"Giresun", "28"},
+		{"Gümüşhane", "29"},
+		{"Hakkâri", "30"},
+		{"Hatay", "31"},
+		{"Isparta", "32"},
+		{"Iğdır", "76"},
+		{"Kahramanmaraş", "46"},
+		{"Karabük", "78"},
+		{"Karaman", "70"},
+		{"Kars", "36"},
+		{"Kastamonu", "37"},
+		{"Kayseri", "38"},
+		{"Kilis", "79"},
+		{"Kocaeli", "41"},
+		{"Konya", "42"},
+		{"Kütahya", "43"},
+		{"Kırklareli", "39"},
+		{"Kırıkkale", "71"},
+		{"Kırşehir", "40"},
+		{"Malatya", "44"},
+		{"Manisa", "45"},
+		{"Mardin", "47"},
+		{"Mersin", "33"},
+		{"Muğla", "48"},
+		{"Muş", "49"},
+		{"Nevşehir", "50"},
+		{"Niğde", "51"},
+		{"Ordu", "52"},
+		{"Osmaniye", "80"},
+		{"Rize", "53"},
+		{"Sakarya", "54"},
+		{"Samsun", "55"},
+		{"Siirt", "56"},
+		{"Sinop", "57"},
+		{"Sivas", "58"},
+		{"Tekirdağ", "59"},
+		{"Tokat", "60"},
+		{"Trabzon", "61"},
+		{"Tunceli", "62"},
+		{"Uşak", "64"},
+		{"Van", "65"},
+		{"Yalova", "77"},
+		{"Yozgat", "66"},
+		{"Zonguldak", "67"},
+		{"Çanakkale", "17"},
+		{"Çankırı", "18"},
+		{"Çorum", "19"},
+		{"İstanbul", "34"},
+		{"İzmir", "35"},
+		{"Şanlıurfa", "63"},
+		{"Şırnak", "73"},
+	},
+	"TT": {
+		{"Arima", "ARI"},
+		{"Chaguanas", "CHA"},
+		{"Couva-Tabaquite-Talparo", "CTT"},
+		{"Diego Martin", "DMN"},
+		{"Mayaro-Rio Claro", "MRC"},
+		{"Penal-Debe", "PED"},
+		{"Point Fortin", "PTF"},
+		{"Port of Spain", "POS"},
+		{"Princes Town", "PRT"},
+		{"San Fernando", "SFO"},
+		{"San Juan-Laventille", "SJL"},
+		{"Sangre Grande", "SGE"},
+		{"Siparia", "SIP"},
+		{"Tobago", "TOB"},
+		{"Tunapuna-Piarco", "TUP"},
+	},
+	"TV": {
+		{"Funafuti", "FUN"},
+		{"Nanumaga", "NMG"},
+		{"Nanumea", "NMA"},
+		{"Niutao", "NIT"},
+		{"Nui", "NUI"},
+		{"Nukufetau", "NKF"},
+		{"Nukulaelae", "NKL"},
+		{"Vaitupu", "VAI"},
+	},
+	"TW": {
+		{"Changhua", "CHA"},
+		{"Chiayi", "CYI"},
+		{"Hsinchu", "HSQ"},
+		{"Hualien", "HUA"},
+		{"Kaohsiung", "KHH"},
+		{"Keelung", "KEE"},
+		{"Kinmen", "KIN"},
+		{"Lienchiang", "LIE"},
+		{"Miaoli", "MIA"},
+		{"Nantou", "NAN"},
+		{"New Taipei", "NWT"},
+		{"Penghu", "PEN"},
+		{"Pingtung", "PIF"},
+		{"Taichung", "TXG"},
+		{"Tainan", "TNN"},
+		{"Taipei", "TPE"},
+		{"Taitung", "TTT"},
+		{"Taoyuan", "TAO"},
+		{"Yilan", "ILA"},
+		{"Yunlin", "YUN"},
+		{"南投", "NAN"},
+		{"嘉義", "CYI"},
+		{"屏東", "PIF"},
+		{"彰化", "CHA"},
+		{"新竹", "HSQ"},
+		{"桃園", "TAO"},
+		{"澎湖", "PEN"},
+		{"臺中", "TXG"},
+		{"臺北", "TPE"},
+		{"臺南", "TNN"},
+		{"臺東", "TTT"},
+		{"花蓮", "HUA"},
+		{"苗栗", "MIA"},
+		{"雲林", "YUN"},
+		{"高雄", "KHH"},
+	},
+	"TZ": {
+		{"Arusha", "01"},
+		{"Coast", "19"},
+		{"Dar es Salaam", "02"},
+		{"Dodoma", "03"},
+		{"Geita", "27"},
+		{"Iringa", "04"},
+		{"Kagera", "05"},
+		{"Katavi", "28"},
+		{"Kigoma", "08"},
+		{"Kilimanjaro", "09"},
+		{"Lindi", "12"},
+		{"Manyara", "26"},
+		{"Mara", "13"},
+		{"Mbeya", "14"},
+		{"Morogoro", "16"},
+		{"Mtwara", "17"},
+		{"Mwanza", "18"},
+		{"Njombe", "29"},
+		{"Pemba North", "06"},
+		{"Pemba South", "10"},
+		{"Rukwa", "20"},
+		{"Ruvuma", "21"},
+		{"Shinyanga", "22"},
+		{"Simiyu", "30"},
+		{"Singida", "23"},
+		{"Songwe", "31"},
+		{"Tabora", "24"},
+		{"Tanga", "25"},
+		{"Zanzibar North", "07"},
+		{"Zanzibar South", "11"},
+		{"Zanzibar West", "15"},
+	},
+	"UA": {
+		{"Avtonomna Respublika Krym", "43"},
+		{"Cherkaska oblast", "71"},
+		{"Chernihivska oblast", "74"},
+		{"Chernivetska oblast", "77"},
+		{"Dnipropetrovska oblast", "12"},
+		{"Donetska oblast", "14"},
+		{"Ivano-Frankivska oblast", "26"},
+		{"Kharkivska oblast", "63"},
+		{"Khersonska oblast", "65"},
+		{"Khmelnytska oblast", "68"},
+		{"Kirovohradska oblast", "35"},
+		{"Kyiv", "30"},
+		{"Kyivska oblast", "32"},
+		{"Luhanska oblast", "09"},
+		{"Lvivska oblast", "46"},
+		{"Mykolaivska oblast", "48"},
+		{"Odeska oblast", "51"},
+		{"Poltavska oblast", "53"},
+		{"Rivnenska oblast", "56"},
+		{"Sevastopol", "40"},
+		{"Sumska oblast", "59"},
+		{"Ternopilska oblast", "61"},
+		{"Vinnytska oblast", "05"},
+		{"Volynska oblast", "07"},
+		{"Zakarpatska oblast", "21"},
+		{"Zaporizka oblast", "23"},
+		{"Zhytomyrska oblast", "18"},
+		{"Івано-Франківська область", "26"},
+		{"Автономна Республіка Крим", "43"},
+		{"Волинська область", "07"},
+		{"Вінницька область", "05"},
+		{"Дніпропетровська область", "12"},
+		{"Донецька область", "14"},
+		{"Житомирська область", "18"},
+		{"Закарпатська область", "21"},
+		{"Запорізька область", "23"},
+		{"Київ", "30"},
+		{"Київська область", "32"},
+		{"Кіровоградська область", "35"},
+		{"Луганська область", "09"},
+		{"Львівська область", "46"},
+		{"Миколаївська область", "48"},
+		{"Одеська область", "51"},
+		{"Полтавська область", "53"},
+		{"Рівненська область", "56"},
+		{"Севастополь", "40"},
+		{"Сумська область", "59"},
+		{"Тернопільська область", "61"},
+		{"Харківська область", "63"},
+		{"Херсонська область", "65"},
+		{"Хмельницька область", "68"},
+		{"Черкаська область", "71"},
+		{"Чернівецька область", "77"},
+		{"Чернігівська область", "74"},
+	},
+	"UG": {
+		{"Central", "C"},
+		{"Eastern", "E"},
+		{"Northern", "N"},
+		{"Western", "W"},
+		{"Abim", "314"},
+		{"Adjumani", "301"},
+		{"Agago", "322"},
+		{"Alebtong", "323"},
+		{"Amolatar", "315"},
+		{"Amudat", "324"},
+		{"Amuria", "216"},
+		{"Amuru", "316"},
+		{"Apac", "302"},
+		{"Arua", "303"},
+		{"Budaka", "217"},
+		{"Bududa", "218"},
+		{"Bugiri", "201"},
+		{"Bugweri", "235"},
+		{"Buhweju", "420"},
+		{"Buikwe", "117"},
+		{"Bukedea", "219"},
+		{"Bukomansibi", "118"},
+		{"Bukwo", "220"},
+		{"Bulambuli", "225"},
+		{"Buliisa", "416"},
+		{"Bundibugyo", "401"},
+		{"Bunyangabu", "430"},
+		{"Bushenyi", "402"},
+		{"Busia", "202"},
+		{"Butaleja", "221"},
+		{"Butambala", "119"},
+		{"Butebo", "233"},
+		{"Buvuma", "120"},
+		{"Buyende", "226"},
+		{"Dokolo", "317"},
+		{"Gomba", "121"},
+		{"Gulu", "304"},
+		{"Hoima", "403"},
+		{"Ibanda", "417"},
+		{"Iganga", "203"},
+		{"Isingiro", "418"},
+		{"Jinja", "204"},
+		{"Kaabong", "318"},
+		{"Kabale", "404"},
+		{"Kabarole", "405"},
+		{"Kaberamaido", "213"},
+		{"Kagadi", "427"},
+		{"Kakumiro", "428"},
+		{"Kalaki", "237"},
+		{"Kalangala", "101"},
+		{"Kaliro", "222"},
+		{"Kalungu", "122"},
+		{"Kampala", "102"},
+		{"Kamuli", "205"},
+		{"Kamwenge", "413"},
+		{"Kanungu", "414"},
+		{"Kapchorwa", "206"},
+		{"Kapelebyong", "236"},
+		{"Karenga", "335"},
+		{"Kasanda", "126"},
+		{"Kasese", "406"},
+		{"Katakwi", "207"},
+		{"Kayunga", "112"},
+		{"Kazo", "433"},
+		{"Kibaale", "407"},
+		{"Kiboga", "103"},
+		{"Kibuku", "227"},
+		{"Kikuube", "432"},
+		{"Kiruhura", "419"},
+		{"Kiryandongo", "421"},
+		{"Kisoro", "408"},
+		{"Kitagwenda", "434"},
+		{"Kitgum", "305"},
+		{"Koboko", "319"},
+		{"Kole", "325"},
+		{"Kotido", "306"},
+		{"Kumi", "208"},
+		{"Kwania", "333"},
+		{"Kween", "228"},
+		{"Kyankwanzi", "123"},
+		{"Kyegegwa", "422"},
+		{"Kyenjojo", "415"},
+		{"Kyotera", "125"},
+		{"Lamwo", "326"},
+		{"Lira", "307"},
+		{"Luuka", "229"},
+		{"Luwero", "104"},
+		{"Lwengo", "124"},
+		{"Lyantonde", "114"},
+		{"Madi-Okollo", "336"},
+		{"Manafwa", "223"},
+		{"Maracha", "320"},
+		{"Masaka", "105"},
+		{"Masindi", "409"},
+		{"Mayuge", "214"},
+		{"Mbale", "209"},
+		{"Mbarara", "410"},
+		{"Mitooma", "423"},
+		{"Mityana", "115"},
+		{"Moroto", "308"},
+		{"Moyo", "309"},
+		{"Mpigi", "106"},
+		{"Mubende", "107"},
+		{"Mukono", "108"},
+		{"Nabilatuk", "334"},
+		{"Nakapiripirit", "311"},
+		{"Nakaseke", "116"},
+		{"Nakasongola", "109"},
+		{"Namayingo", "230"},
+		{"Namisindwa", "234"},
+		{"Namutumba", "224"},
+		{"Napak", "327"},
+		{"Nebbi", "310"},
+		{"Ngora", "231"},
+		{"Ntoroko", "424"},
+		{"Ntungamo", "411"},
+		{"Nwoya", "328"},
+		{"Obongi", "337"},
+		{"Omoro", "331"},
+		{"Otuke", "329"},
+		{"Oyam", "321"},
+		{"Pader", "312"},
+		{"Pakwach", "332"},
+		{"Pallisa", "210"},
+		{"Rakai", "110"},
+		{"Rubanda", "429"},
+		{"Rubirizi", "425"},
+		{"Rukiga", "431"},
+		{"Rukungiri", "412"},
+		{"Rwampara", "435"},
+		{"Sembabule", "111"},
+		{"Serere", "232"},
+		{"Sheema", "426"},
+		{"Sironko", "215"},
+		{"Soroti", "211"},
+		{"Tororo", "212"},
+		{"Wakiso", "113"},
+		{"Yumbe", "313"},
+		{"Zombo", "330"},
+	},
+	"UM": {
+		{"Baker Island", "81"},
+		{"Howland Island", "84"},
+		{"Jarvis Island", "86"},
+		{"Johnston Atoll", "67"},
+		{"Kingman Reef", "89"},
+		{"Midway Islands", "71"},
+		{"Navassa Island", "76"},
+		{"Palmyra Atoll", "95"},
+		{"Wake Island", "79"},
+	},
+	"US": {
+		{"Alabama", "AL"},
+		{"Alaska", "AK"},
+		{"American Samoa", "AS"},
+		{"Arizona", "AZ"},
+		{"Arkansas", "AR"},
+		{"California", "CA"},
+		{"Colorado", "CO"},
+		{"Connecticut", "CT"},
+		{"Delaware", "DE"},
+		{"District of Columbia", "DC"},
+		{"Florida", "FL"},
+		{"Georgia", "GA"},
+		{"Guam", "GU"},
+		{"Hawaii", "HI"},
+		{"Idaho", "ID"},
+		{"Illinois", "IL"},
+		{"Indiana", "IN"},
+		{"Iowa", "IA"},
+		{"Kansas", "KS"},
+		{"Kentucky", "KY"},
+		{"Louisiana", "LA"},
+		{"Maine", "ME"},
+		{"Maryland", "MD"},
+		{"Massachusetts", "MA"},
+		{"Michigan", "MI"},
+		{"Minnesota", "MN"},
+		{"Mississippi", "MS"},
+		{"Missouri", "MO"},
+		{"Montana", "MT"},
+		{"Nebraska", "NE"},
+		{"Nevada", "NV"},
+		{"New Hampshire", "NH"},
+		{"New Jersey", "NJ"},
+		{"New Mexico", "NM"},
+		{"New York", "NY"},
+		{"North Carolina", "NC"},
+		{"North Dakota", "ND"},
+		{"Northern Mariana Islands", "MP"},
+		{"Ohio", "OH"},
+		{"Oklahoma", "OK"},
+		{"Oregon", "OR"},
+		{"Pennsylvania", "PA"},
+		{"Puerto Rico", "PR"},
+		{"Rhode Island", "RI"},
+		{"South Carolina", "SC"},
+		{"South Dakota", "SD"},
+		{"Tennessee", "TN"},
+		{"Texas", "TX"},
+		{"United States Minor Outlying Islands", "UM"},
+		{"Utah", "UT"},
+		{"Vermont", "VT"},
+		{"Virgin Islands, U.S.", "VI"},
+		{"Virginia", "VA"},
+		{"Washington", "WA"},
+		{"West Virginia", "WV"},
+		{"Wisconsin", "WI"},
+		{"Wyoming", "WY"},
+		{"U.S. Virgin Islands", "VI"},
+		{"Virgin Islands", "VI"},
+	},
+	"UY": {
+		{"Artigas", "AR"},
+		{"Canelones", "CA"},
+		{"Cerro Largo", "CL"},
+		{"Colonia", "CO"},
+		{"Durazno", "DU"},
+		{"Flores", "FS"},
+		{"Florida", "FD"},
+		{"Lavalleja", "LA"},
+		{"Maldonado", "MA"},
+		{"Montevideo", "MO"},
+		{"Paysandú", "PA"},
+		{"Rivera", "RV"},
+		{"Rocha", "RO"},
+		{"Río Negro", "RN"},
+		{"Salto", "SA"},
+		{"San José", "SJ"},
+		{"Soriano", "SO"},
+		{"Tacuarembó", "TA"},
+		{"Treinta y Tres", "TT"},
+	},
+	"UZ": {
+		{"Andijon", "AN"},
+		{"Buxoro", "BU"},
+		{"Farg‘ona", "FA"},
+		{"Jizzax", "JI"},
+		{"Namangan", "NG"},
+		{"Navoiy", "NW"},
+		{"Qashqadaryo", "QA"},
+		{"Qoraqalpog‘iston Respublikasi", "QR"},
+		{"Samarqand", "SA"},
+		{"Sirdaryo", "SI"},
+		{"Surxondaryo", "SU"},
+		{"Toshkent", "TK"},
+		{"Xorazm", "XO"},
+	},
+	"VC": {
+		{"Charlotte", "01"},
+		{"Grenadines", "06"},
+		{"Saint Andrew", "02"},
+		{"Saint David", "03"},
+		{"Saint George", "04"},
+		{"Saint Patrick", "05"},
+	},
+	"VE": {
+		{"Amazonas", "Z"},
+		{"Anzoátegui", "B"},
+		{"Apure", "C"},
+		{"Aragua", "D"},
+		{"Barinas", "E"},
+		{"Bolívar", "F"},
+		{"Carabobo", "G"},
+		{"Cojedes", "H"},
+		{"Delta Amacuro", "Y"},
+		{"Dependencias Federales", "W"},
+		{"Distrito Capital", "A"},
+		{"Falcón", "I"},
+		{"Guárico", "J"},
+		{"La Guaira", "X"},
+		{"Lara", "K"},
+		{"Miranda", "M"},
+		{"Monagas", "N"},
+		{"Mérida", "L"},
+		{"Nueva Esparta", "O"},
+		{"Portuguesa", "P"},
+		{"Sucre", "R"},
+		{"Trujillo", "T"},
+		{"Táchira", "S"},
+		{"Yaracuy", "U"},
+		{"Zulia", "V"},
+	},
+	"VN": {
+		{"An Giang", "44"},
+		{"Bà Rịa - Vũng Tàu", "43"},
+		{"Bình Dương", "57"},
+		{"Bình Phước", "58"},
+		{"Bình Thuận", "40"},
+		{"Bình Định", "31"},
+		{"Bạc Liêu", "55"},
+		{"Bắc Giang", "54"},
+		{"Bắc Kạn", "53"},
+		{"Bắc Ninh", "56"},
+		{"Bến Tre", "50"},
+		{"Cao Bằng", "04"},
+		{"Cà Mau", "59"},
+		{"Cần Thơ", "CT"},
+		{"Gia Lai", "30"},
+		{"Hà Giang", "03"},
+		{"Hà Nam", "63"},
+		{"Hà Nội", "HN"},
+		{"Hà Tĩnh", "23"},
+		{"Hòa Bình", "14"},
+		{"Hưng Yên", "66"},
+		{"Hải Dương", "61"},
+		{"Hải Phòng", "HP"},
+		{"Hậu Giang", "73"},
+		{"Hồ Chí Minh", "SG"},
+		{"Khánh Hòa", "34"},
+		{"Kiến Giang", "47"},
+		{"Kon Tum", "28"},
+		{"Lai Châu", "01"},
+		{"Long An", "41"},
+		{"Lào Cai", "02"},
+		{"Lâm Đồng", "35"},
+		{"Lạng Sơn", "09"},
+		{"Nam Định", "67"},
+		{"Nghệ An", "22"},
+		{"Ninh Bình", "18"},
+		{"Ninh Thuận", "36"},
+		{"Phú Thọ", "68"},
+		{"Phú Yên", "32"},
+		{"Quảng Bình", "24"},
+		{"Quảng Nam", "27"},
+		{"Quảng Ngãi", "29"},
+		{"Quảng Ninh", "13"},
+		{"Quảng Trị", "25"},
+		{"Sóc Trăng", "52"},
+		{"Sơn La", "05"},
+		{"Thanh Hóa", "21"},
+		{"Thái Bình", "20"},
+		{"Thái Nguyên", "69"},
+		{"Thừa Thiên-Huế", "26"},
+		{"Tiền Giang", "46"},
+		{"Trà Vinh", "51"},
+		{"Tuyên Quang", "07"},
+		{"Tây Ninh", "37"},
+		{"Vĩnh Long", "49"},
+		{"Vĩnh Phúc", "70"},
+		{"Yên Bái", "06"},
+		{"Điện Biên", "71"},
+		{"Đà Nẵng", "DN"},
+		{"Đắk Lắk", "33"},
+		{"Đắk Nông", "72"},
+		{"Đồng Nai", "39"},
+		{"Đồng Tháp", "45"},
+	},
+	"VU": {
+		{"Malampa", "MAP"},
+		{"Pénama", "PAM"},
+		{"Sanma", "SAM"},
+		{"Shéfa", "SEE"},
+		{"Taféa", "TAE"},
+		{"Torba", "TOB"},
+	},
+	"WF": {
+		{"Alo", "AL"},
+		{"Sigave", "SG"},
+		{"Uvea", "UV"},
+	},
+	"WS": {
+		{"A'ana", "AA"},
+		{"Aiga-i-le-Tai", "AL"},
+		{"Atua", "AT"},
+		{"Fa'asaleleaga", "FA"},
+		{"Gaga'emauga", "GE"},
+		{"Gagaifomauga", "GI"},
+		{"Palauli", "PA"},
+		{"Satupa'itea", "SA"},
+		{"Tuamasaga", "TU"},
+		{"Va'a-o-Fonoti", "VF"},
+		{"Vaisigano", "VS"},
+	},
+	"YE": {
+		{"Abyan", "AB"},
+		{"Al Bayḑā’", "BA"},
+		{"Al Jawf", "JA"},
+		{"Al Mahrah", "MR"},
+		{"Al Maḩwīt", "MW"},
+		{"Al Ḩudaydah", "HU"},
+		{"Amānat al ‘Āşimah", "SA"},
+		{"Arkhabīl Suquţrá", "SU"},
+		{"Aḑ Ḑāli‘", "DA"},
+		{"Dhamār", "DH"},
+		{"Ibb", "IB"},
+		{"Laḩij", "LA"},
+		{"Ma’rib", "MA"},
+		{"Raymah", "RA"},
+		{"Shabwah", "SH"},
+		{"Tāʻizz", "TA"},
+		{"Şanʻā’", "SN"},
+		{"Şāʻdah", "SD"},
+		{"Ḩajjah", "HJ"},
+		{"Ḩaḑramawt", "HD"},
+		{"‘Adan", "AD"},
+		{"‘Amrān", "AM"},
+		{"city", "SA"},
+	},
+	"ZA": {
+		{"Eastern Cape", "EC"},
+		{"Free State", "FS"},
+		{"Gauteng", "GP"},
+		{"Kwazulu-Natal", "KZN"},
+		{"Limpopo", "LP"},
+		{"Mpumalanga", "MP"},
+		{"North-West", "NW"},
+		{"Northern Cape", "NC"},
+		{"Western Cape", "WC"},
+	},
+	"ZM": {
+		{"Central", "02"},
+		{"Copperbelt", "08"},
+		{"Eastern", "03"},
+		{"Luapula", "04"},
+		{"Lusaka", "09"},
+		{"Muchinga", "10"},
+		{"North-Western", "06"},
+		{"Northern", "05"},
+		{"Southern", "07"},
+		{"Western", "01"},
+	},
+	"ZW": {
+		{"Bulawayo", "BU"},
+		{"Harare", "HA"},
+		{"Manicaland", "MA"},
+		{"Mashonaland Central", "MC"},
+		{"Mashonaland East", "ME"},
+		{"Mashonaland West", "MW"},
+		{"Masvingo", "MV"},
+		{"Matabeleland North", "MN"},
+		{"Matabeleland South", "MS"},
+		{"Midlands", "MI"},
+	},
+}
@@ -0,0 +1,71 @@
+package main
+
+import "testing"
+
+func TestSubdivisionCode(t *testing.T) {
+	tests := []struct {
+		state, country, want string
+	}{
+		{"Maine", "United States", "ME"},
+		{"maine", "US", "ME"},
+		{"ME", "US", "ME"},
+		{"Maine", "", "ME"},
+		{"Ontario", "", "ON"},
+		{"Québec", "Canada", "QC"},
+		{"Quebec", "Canada", "QC"},
+		{"Bayern", "Germany", "BY"},
+		{"Bavaria", "DE", "BY"},
+		{"Zürich", "Switzerland", "ZH"},
+		{"Zurich", "CH", "ZH"},
+		{"ZÜRICH", "CH", "ZH"},
+		{"București", "Romania", "B"},
+		{"Bucuresti", "Romania", "B"},
+		{"Timiș", "Romania", "TM"},
+		{"Timis", "Romania", "TM"},
+		{"Constanța", "RO", "CT"},
+		{"Łódzkie", "Poland", "10"},
+		{"Lodzkie", "Poland", "10"},
+		{"Madrid", "Spain", "MD"},
+		{"Comunidad de Madrid", "ES", "MD"},
+		{"Cornwall", "United Kingdom", "CON"},
+		{"Scotland", "GB", "SCT"},
+		{"Vale of Glamorgan", "GB", "VGL"},
+		{"Αττική", "Greece", "I"},
+		{"Attikí", "Greece", "I"},
+		{"Attiki", "GR", "I"},
+		{"Κρήτη", "GR", "M"},
+		{"Москва", "Russia", "MOW"},
+		{"Moskva", "Russia", "MOW"},
+		{"Київська область", "Ukraine", "32"},
+		{"Kyivska oblast", "UA", "32"},
+		{"Београд", "Serbia", "00"},
+		{"北海道", "Japan", "01"},
+		{"Hokkaido", "Japan", "01"},
+		{"Nowhere", "United States", ""},
+		{"Αθήνα", "Greece", ""},
+		{"", "US", ""},
+	}
+	for _, tt := range tests {
+		if got := subdivisionCode(tt.state, tt.country); got != tt.want {
+			t.Errorf("subdivisionCode(%q, %q) = %q, want %q", tt.state, tt.country, got, tt.want)
+		}
+	}
+}
+
+func TestCountryCode(t *testing.T) {
+	tests := []struct {
+		country, want string
+	}{
+		{"United States", "US"},
+		{"us", "US"},
+		{"Germany", "DE"},
+		{"Côte d'Ivoire", "CI"},
+		{"Cote d'Ivoire", "CI"},
+		{"Atlantis", ""},
+	}
+	for _, tt := range tests {
+		if got := countryCode(tt.country); got != tt.want {
+			t.Errorf("countryCode(%q) = %q, want %q", tt.country, got, tt.want)
+		}
+	}
+}
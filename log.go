@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jancona/dmrfill/api"
+)
+
+// Log messages go to stderr through log/slog, as text for people or JSON for tools
+
+const (
+	logFormatText = "text"
+	logFormatJSON = "json"
+)
+
+// logger is replaced by newLogger once the options are parsed
+var logger = slog.New(textLogHandler{level: slog.LevelInfo})
+
+// newLogger creates the logger for the -log_format option. Debug messages are shown with -v and
+// api.LevelTrace messages with -vv.
+func newLogger() error {
+	level := slog.LevelInfo
+	if veryVerbose {
+		level = api.LevelTrace
+	} else if verbose {
+		level = slog.LevelDebug
+	}
+	switch logFormat {
+	case logFormatText:
+		logger = slog.New(textLogHandler{level: level})
+	case logFormatJSON:
+		logger = slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{
+			Level: level,
+			ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+				if a.Key == slog.LevelKey && len(groups) == 0 && a.Value.Any() == api.LevelTrace {
+					a.Value = slog.StringValue("TRACE")
+				}
+				return a
+			},
+		}))
+	default:
+		return fmt.Errorf("log_format must be one of (%s %s)", logFormatText, logFormatJSON)
+	}
+	return nil
+}
+
+func fatal(f string, args ...any) {
+	msg := fmt.Sprintf(f, args...)
+	logger.Error(msg)
+	report.fail(msg)
+	os.Exit(1)
+}
+
+// textLogHandler writes a line for each message, like 'msg key=value', with a prefix for
+// warnings and errors
+type textLogHandler struct {
+	level slog.Level
+	attrs []slog.Attr
+}
+
+func (h textLogHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.level
+}
+
+func (h textLogHandler) Handle(_ context.Context, r slog.Record) error {
+	var b strings.Builder
+	switch {
+	case r.Level >= slog.LevelError:
+		b.WriteString("error: ")
+	case r.Level >= slog.LevelWarn:
+		b.WriteString("warning: ")
+	}
+	b.WriteString(r.Message)
+	add := func(a slog.Attr) bool {
+		v := a.Value.Resolve().String()
+		if v == "" || strings.ContainsAny(v, " =\"") {
+			v = strconv.Quote(v)
+		}
+		fmt.Fprintf(&b, " %s=%s", a.Key, v)
+		return true
+	}
+	for _, a := range h.attrs {
+		add(a)
+	}
+	r.Attrs(add)
+	fmt.Fprintln(os.Stderr, b.String())
+	return nil
+}
+
+func (h textLogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return textLogHandler{level: h.level, attrs: append(slices.Clip(h.attrs), attrs...)}
+}
+
+func (h textLogHandler) WithGroup(string) slog.Handler {
+	return h
+}
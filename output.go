@@ -0,0 +1,90 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/jancona/dmrfill/codeplug"
+)
+
+// backupTimeFormat is used to name backup files, e.g. codeplug.yaml.20240615-093000.bak
+const backupTimeFormat = "20060102-150405"
+
+// checkOutputFile sets the output file for -inplace and makes sure that the input file won't be
+// overwritten by accident
+func checkOutputFile() error {
+	if inPlace {
+		if inFile == "" {
+			return errors.New("inplace requires an input file set with -in")
+		}
+		if outFile != "" && !sameFile(inFile, outFile) {
+			return errors.New("inplace can't be used with a different -out file")
+		}
+		outFile = inFile
+		return nil
+	}
+	if backup {
+		return errors.New("backup can only be used with -inplace")
+	}
+	if inFile != "" && outFile != "" && sameFile(inFile, outFile) {
+		return fmt.Errorf("output file %s is the input file, use -inplace to update it", outFile)
+	}
+	return nil
+}
+
+// sameFile returns true if the paths name the same file
+func sameFile(a, b string) bool {
+	aInfo, aErr := os.Stat(a)
+	bInfo, bErr := os.Stat(b)
+	if aErr == nil && bErr == nil {
+		return os.SameFile(aInfo, bInfo)
+	}
+	aAbs, aErr := filepath.Abs(a)
+	bAbs, bErr := filepath.Abs(b)
+	return aErr == nil && bErr == nil && aAbs == bAbs
+}
+
+// writeOutput writes the codeplug to the output file or stdout. The output file is only replaced
+// once the codeplug is complete, so a failed or interrupted run doesn't leave a partial file.
+func writeOutput(cp *codeplug.Codeplug) error {
+	if outFile == "" {
+		output, err := cp.Marshal()
+		if err != nil {
+			return err
+		}
+		_, err = os.Stdout.Write(output)
+		return err
+	}
+	if backup {
+		name, err := backupFile(outFile)
+		if err != nil {
+			return fmt.Errorf("error backing up %s: %v", outFile, err)
+		}
+		if name != "" {
+			logger.Debug("saved a backup", "file", outFile, "backup", name)
+		}
+	}
+	return cp.Save(outFile)
+}
+
+// backupFile copies the file to a timestamped .bak file next to it and returns its name
+func backupFile(fileName string) (string, error) {
+	info, err := os.Stat(fileName)
+	if errors.Is(err, fs.ErrNotExist) {
+		// Nothing to back up
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return "", err
+	}
+	name := fileName + "." + time.Now().Format(backupTimeFormat) + ".bak"
+	return name, os.WriteFile(name, data, info.Mode().Perm())
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jancona/dmrfill/codeplug"
+)
+
+// setOutputFlags sets the output flags for the duration of a test
+func setOutputFlags(t *testing.T, in, out string, inplace, bak bool) {
+	savedIn, savedOut, savedInPlace, savedBackup := inFile, outFile, inPlace, backup
+	t.Cleanup(func() { inFile, outFile, inPlace, backup = savedIn, savedOut, savedInPlace, savedBackup })
+	inFile, outFile, inPlace, backup = in, out, inplace, bak
+}
+
+func TestCheckOutputFile(t *testing.T) {
+	dir := t.TempDir()
+	in := filepath.Join(dir, "codeplug.yaml")
+	err := os.WriteFile(in, []byte("settings: {}\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The same file by another path
+	link := filepath.Join(dir, "link.yaml")
+	err = os.Symlink(in, link)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other := filepath.Join(dir, "other.yaml")
+	tests := []struct {
+		in, out       string
+		inplace, bak  bool
+		wantOut       string
+		wantErrPrefix string
+	}{
+		{in, other, false, false, other, ""},
+		{in, "", false, false, "", ""},
+		{"", other, false, false, other, ""},
+		{in, "", true, false, in, ""},
+		{in, "", true, true, in, ""},
+		{in, link, true, false, in, ""},
+		{in, in, true, false, in, ""},
+		{"", "", true, false, "", "inplace requires an input file"},
+		{in, other, true, false, other, "inplace can't be used with a different -out file"},
+		{in, other, false, true, other, "backup can only be used with -inplace"},
+		{in, in, false, false, in, "output file " + in + " is the input file, use -inplace to update it"},
+		{in, link, false, false, link, "output file " + link + " is the input file"},
+	}
+	for _, tt := range tests {
+		setOutputFlags(t, tt.in, tt.out, tt.inplace, tt.bak)
+		err := checkOutputFile()
+		switch {
+		case tt.wantErrPrefix == "" && err != nil:
+			t.Errorf("checkOutputFile() with -in %q -out %q -inplace=%v -backup=%v returned error %v",
+				tt.in, tt.out, tt.inplace, tt.bak, err)
+		case tt.wantErrPrefix != "" && (err == nil || !strings.HasPrefix(err.Error(), tt.wantErrPrefix)):
+			t.Errorf("checkOutputFile() with -in %q -out %q -inplace=%v -backup=%v error = %v, want %q",
+				tt.in, tt.out, tt.inplace, tt.bak, err, tt.wantErrPrefix)
+		case err == nil && outFile != tt.wantOut:
+			t.Errorf("checkOutputFile() with -in %q -out %q -inplace=%v -backup=%v set output %q, want %q",
+				tt.in, tt.out, tt.inplace, tt.bak, outFile, tt.wantOut)
+		}
+	}
+}
+
+const outputSource = "settings:\n  introLine1: Hello\n"
+
+// updatedCodeplug writes outputSource to a temporary file and returns its name and the
+// codeplug parsed from it with a changed setting
+func updatedCodeplug(t *testing.T) (string, *codeplug.Codeplug) {
+	t.Helper()
+	fileName := filepath.Join(t.TempDir(), "codeplug.yaml")
+	err := os.WriteFile(fileName, []byte(outputSource), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cp, err := codeplug.Load(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cp.Settings.IntroLine1 = "Goodbye"
+	return fileName, cp
+}
+
+func TestWriteOutputBackup(t *testing.T) {
+	fileName, cp := updatedCodeplug(t)
+	setOutputFlags(t, fileName, fileName, true, true)
+	err := writeOutput(cp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := os.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(out), "introLine1: Goodbye") {
+		t.Errorf("%s wasn't updated:\n%s", fileName, out)
+	}
+	backups, err := filepath.Glob(fileName + ".*.bak")
+	if err != nil || len(backups) != 1 {
+		t.Fatalf("got backups %v, %v, want one", backups, err)
+	}
+	b, err := os.ReadFile(backups[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != outputSource {
+		t.Errorf("backup %s = %q, want %q", backups[0], b, outputSource)
+	}
+	if info, err := os.Stat(backups[0]); err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("backup doesn't have the permissions of the input file: %v %v", info.Mode(), err)
+	}
+}
+
+func TestWriteOutputNoBackup(t *testing.T) {
+	fileName, cp := updatedCodeplug(t)
+	setOutputFlags(t, fileName, fileName, true, false)
+	err := writeOutput(cp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if backups, _ := filepath.Glob(fileName + ".*.bak"); len(backups) != 0 {
+		t.Errorf("got backups %v without -backup", backups)
+	}
+}
+
+// If the backup can't be written, the file must be left alone
+func TestWriteOutputBackupFails(t *testing.T) {
+	fileName, cp := updatedCodeplug(t)
+	setOutputFlags(t, fileName, fileName, true, true)
+	// Directories in the way of the backup files make writing them fail, even as root
+	for i := range 5 {
+		name := fileName + "." + time.Now().Add(time.Duration(i)*time.Second).Format(backupTimeFormat) + ".bak"
+		err := os.Mkdir(name, 0755)
+		if err != nil && !os.IsExist(err) {
+			t.Fatal(err)
+		}
+	}
+	err := writeOutput(cp)
+	if err == nil || !strings.HasPrefix(err.Error(), "error backing up "+fileName) {
+		t.Errorf("writeOutput() error = %v, want a backup error", err)
+	}
+	out, err := os.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != outputSource {
+		t.Errorf("%s was changed after the backup failed:\n%s", fileName, out)
+	}
+}
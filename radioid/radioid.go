@@ -0,0 +1,320 @@
+// Package radioid is a client for the RadioID.net DMR repeater API
+package radioid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jancona/dmrfill/api"
+	"github.com/jancona/dmrfill/band"
+	"github.com/jancona/dmrfill/filter"
+	"github.com/jancona/dmrfill/geo"
+)
+
+// API Doc: https://radioid.net/database/api
+// Example: https://radioid.net/api/dmr/repeater/?state=Maine
+const DefaultBaseURL = "https://radioid.net/api/dmr/repeater/"
+
+var talkGroupRegex = regexp.MustCompile(`Time Slot # ?(\d) [-=] Group Call (\d+)(\s*[-=]\s*([^"<>]*))?`)
+var lastUpdatedRegex = regexp.MustCompile(`Last Update: (\d+-\d+-\d+ \d+:\d+:\d+)`)
+
+// Supported query parameters
+var queryParamNames = map[string]struct{}{
+	"id":        {}, // DMR Repeater ID
+	"callsign":  {}, // Repeater callsign
+	"city":      {}, // Repeater city
+	"state":     {}, // Repeater state / province
+	"country":   {}, // Repeater country
+	"frequency": {}, // Repeater frequency
+	"trustee":   {}, // Trustee callsign
+}
+
+// fields maps filter keys to Repeater field names, or to "" for computed fields
+var fields = map[string]string{}
+
+// Put the Repeater JSON field names in a map
+func init() {
+	st := reflect.TypeOf(Repeater{})
+	for i := 0; i < st.NumField(); i++ {
+		field := st.Field(i)
+		tag := strings.Split(field.Tag.Get("json"), ",")[0]
+		if !field.IsExported() || tag == "-" {
+			continue
+		}
+		if tag != "" {
+			// Filter keys use spaces in place of underscores
+			fields[strings.ReplaceAll(tag, "_", " ")] = field.Name
+		} else {
+			fields[strings.ToLower(field.Name)] = field.Name
+		}
+	}
+	fields["network"] = "IPSCNetwork"
+	fields["has"] = "" // computed
+}
+
+// Options configures a Client
+type Options struct {
+	HTTPClient *http.Client // default api.DefaultClient
+	BaseURL    string       // default DefaultBaseURL
+	UserAgent  string
+	Logger     *slog.Logger // default no logging
+}
+
+// Query describes the repeaters to search for
+type Query struct {
+	// Filters on fields that RadioID supports as query parameters are sent with the request,
+	// the others are applied to the results
+	Filters filter.Filters
+	// Only return repeaters that have talkgroups listed in their details
+	TalkgroupsRequired bool
+}
+
+// Client queries RadioID
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	userAgent  string
+	logger     *slog.Logger
+}
+
+// NewClient returns a client configured by opts
+func NewClient(opts Options) *Client {
+	c := &Client{
+		httpClient: opts.HTTPClient,
+		baseURL:    opts.BaseURL,
+		userAgent:  opts.UserAgent,
+		logger:     api.Logger(opts.Logger),
+	}
+	if c.httpClient == nil {
+		c.httpClient = api.DefaultClient
+	}
+	if c.baseURL == "" {
+		c.baseURL = DefaultBaseURL
+	}
+	return c
+}
+
+// Query returns the repeaters that match the query. Talkgroups are parsed from the repeater
+// details.
+func (c *Client) Query(ctx context.Context, q Query) (*Results, error) {
+	baseURL, err := url.Parse(c.baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing base URL %s: %v", c.baseURL, err)
+	}
+
+	// Filters to be applied on the results
+	var resultFilters filter.Filters
+
+	// Query params
+	params := url.Values{}
+	for _, f := range q.Filters {
+		_, ok := queryParamNames[f.Key]
+		if ok && f.IsQuery() {
+			for _, v := range f.Values {
+				params.Add(f.Key, v)
+			}
+		} else {
+			_, ok := fields[f.Key]
+			if ok {
+				resultFilters = append(resultFilters, f)
+			} else {
+				c.logger.Debug("ignoring filter on unknown field", "filter", f)
+			}
+		}
+	}
+	baseURL.RawQuery = params.Encode()
+	c.logger.Debug("RadioID request", "url", baseURL.String())
+	req, err := api.NewRequest(ctx, baseURL.String(), c.userAgent)
+	if err != nil {
+		return nil, err
+	}
+	body, err := api.Fetch(c.httpClient, req, c.logger)
+	if err != nil {
+		return nil, err
+	}
+	result := Results{
+		Results: []Repeater{},
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing JSON response: %v", err)
+	}
+	c.logger.Debug("RadioID results", "count", result.Count)
+	// Do client filtering
+	newResults := []Repeater{}
+	for _, r := range result.Results {
+		f, err := strconv.ParseFloat(r.Frequency, 64)
+		if err == nil {
+			r.Band = band.Name(f)
+		}
+		// Parse LastUpdated
+		m := lastUpdatedRegex.FindAllStringSubmatch(r.Details, -1)
+		if len(m) > 0 {
+			r.LastUpdated, err = time.Parse("2006-01-02 15:04:05", m[0][1])
+			if err != nil {
+				return nil, fmt.Errorf("error parsing LastUpdated %s: %v", m[0][1], err)
+			}
+		}
+		matchesAll := true
+		for _, rf := range resultFilters {
+			if !rf.Matches(r, fields) {
+				c.logger.Log(ctx, api.LevelTrace, "repeater doesn't match filter", "repeater", r.Callsign, "filter", rf)
+				matchesAll = false
+				break
+			}
+		}
+		if matchesAll {
+			// Parse talk groups from details field
+			var detailsTGs []TalkGroup
+			m = talkGroupRegex.FindAllStringSubmatch(r.Details, -1)
+			for _, s := range m {
+				id, err := strconv.Atoi(s[2])
+				if err != nil {
+					return nil, fmt.Errorf("error parsing TalkGroup ID %s: %v", s[2], err)
+				}
+				ts, err := strconv.Atoi(s[1])
+				if err != nil {
+					return nil, fmt.Errorf("error parsing TalkGroup TimeSlot %s: %v", s[1], err)
+				}
+				name := s[4]
+				if ts == 1 || ts == 2 {
+					detailsTGs = append(detailsTGs, TalkGroup{
+						Number:   id,
+						TimeSlot: ts,
+						Name:     name,
+					})
+				} else {
+					c.logger.Debug("skipping details talkgroup with bad timeslot", "number", id, "name", name, "timeslot", ts)
+				}
+			}
+			c.logger.Debug("talkgroups in details", "repeater", r.Callsign, "count", len(detailsTGs))
+			r.TalkGroups = detailsTGs
+			if !q.TalkgroupsRequired || len(r.TalkGroups) > 0 {
+				newResults = append(newResults, r)
+			} else {
+				c.logger.Debug("skipping repeater with no talkgroups", "repeater", r.Callsign)
+			}
+		}
+	}
+	result.Count = len(newResults)
+	result.Results = newResults
+	c.logger.Debug("RadioID results after filtering", "count", result.Count)
+	return &result, nil
+}
+
+type Results struct {
+	Count   int
+	Results []Repeater
+}
+
+// Repeater is a RadioID repeater
+type Repeater struct {
+	Callsign       string    `json:"callsign"`        // "KC1FRJ"
+	City           string    `json:"city"`            // "Presque Isle"
+	ColorCode      int       `json:"color_code"`      // 12
+	Country        string    `json:"country"`         // "United States"
+	Details        string    `json:"details"`         // "Time Slot #1 - Group Call 759 = SKYWARN\u003Cbr\u003ETime Slot #1 - Group Call 9998 = Parrot*\u003Cbr\u003ETime Slot #1 - Group Call 1 = World Wide*\u003Cbr\u003ETime Slot #1 - Group Call 13 = WW English*\u003Cbr\u003ETime Slot #1 - Group Call 3 = North America\u003Cbr\u003ETime Slot #1 - Group Call 3172 = Northeast\u003Cbr\u003ETime Slot #1 - Group Call 310 = TAC310*\u003Cbr\u003ETime Slot #1 - Group Call 311 = TAC311*\u003Cbr\u003ETime Slot #1 - Group Call 113 = UA English 1*\u003Cbr\u003ETime Slot #1 - Group Call 123 = UA English 2*\u003Cbr\u003ETime Slot #1 - Group Call 8801 = NETAC 1*\u003Cbr\u003E------------------------------------\u003Cbr\u003ETime Slot #2 - Group Call 8802 = NETAC 2*\u003Cbr\u003ETime Slot #2 - Group Call 3181 = New England Wide\u003Cbr\u003ETime Slot #2 - Group Call 8 = Region North\u003Cbr\u003ETime Slot #2 - Group Call 3133 = NH Statewide\u003Cbr\u003ETime Slot #2 - Group Call 3123 = ME Statewide\u003Cbr\u003ETime Slot #1 - Group Call 3029 = New Brunswick\u003Cbr\u003ETime Slot #2 - Group Call 9 = Local Site\u003Cbr\u003E\u003Cbr\u003E* PTT Activated\u003Cbr\u003E\u003Cbr\u003EYou Must Have [ARS] Disabled Within Your Radio\u003Cbr\u003EContact: Dave, KQ1L\u003Cbr\u003EEmail: dhawke@gwi.net\u003Cbr\u003EWebsite: http://nedecn.org"
+	Frequency      string    `json:"frequency"`       // "145.18000"
+	ID             int       `json:"id"`              // 310198
+	IPSCNetwork    string    `json:"ipsc_network"`    // "NEDECN"
+	Offset         string    `json:"offset"`          // "-0.600"
+	RfinderDetails int       `json:"rfinder_details"` // 0
+	State          string    `json:"state"`           // "Maine"
+	Trustee        string    `json:"trustee"`         // "KC1FRJ"
+	TSLinked       string    `json:"ts_linked"`       // "TS1 TS2"
+	LastUpdated    time.Time `json:"last_update"`     // Parsed from Details
+	Band           string
+	TalkGroups     []TalkGroup
+	// RadioID doesn't have these, they come from the corresponding RepeaterBook result
+	County   string
+	Landmark string
+	Location *geo.Point
+	Center   *geo.Center `json:"-"` // The proximity search center that found the repeater
+}
+
+func (r Repeater) GetCallsign() string {
+	return r.Callsign
+}
+func (r Repeater) GetCity() string {
+	return r.City
+}
+func (r Repeater) GetFrequency() string {
+	return r.Frequency
+}
+func (r Repeater) GetState() string {
+	return r.State
+}
+func (r Repeater) GetCountry() string {
+	return r.Country
+}
+func (r Repeater) GetCounty() string {
+	return r.County
+}
+func (r Repeater) GetLandmark() string {
+	return r.Landmark
+}
+func (r Repeater) GetLocation() (geo.Point, bool) {
+	if r.Location == nil {
+		return geo.Point{}, false
+	}
+	return *r.Location, true
+}
+func (r Repeater) GetCenter() (geo.Center, bool) {
+	if r.Center == nil {
+		return geo.Center{}, false
+	}
+	return *r.Center, true
+}
+func (r Repeater) GetOffset() (float64, bool) {
+	offset, err := strconv.ParseFloat(r.Offset, 64)
+	return offset, err == nil
+}
+func (r Repeater) GetColorCode() string {
+	return strconv.Itoa(r.ColorCode)
+}
+func (r Repeater) GetTone() string {
+	return ""
+}
+func (r Repeater) GetLastUpdate() time.Time {
+	return r.LastUpdated
+}
+func (r Repeater) GetNetwork() string {
+	return r.IPSCNetwork
+}
+
+// Features returns the 'has' filter values: network if the repeater belongs to an IPSC network
+// and ts1 or ts2 for linked time slots
+func (r Repeater) Features() []string {
+	var features []string
+	if r.IPSCNetwork != "" {
+		features = append(features, "network")
+	}
+	for _, ts := range strings.Fields(strings.ToLower(r.TSLinked)) {
+		features = append(features, ts)
+	}
+	return features
+}
+
+// ComputedField returns the values of the computed filter fields
+func (r Repeater) ComputedField(key string) ([]string, bool) {
+	if key == "has" {
+		return r.Features(), true
+	}
+	return nil, false
+}
+
+// TalkGroup is a talkgroup listed in the repeater details
+type TalkGroup struct {
+	Number   int
+	TimeSlot int
+	Name     string
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/jancona/dmrfill/codeplug"
+	"github.com/jancona/dmrfill/filter"
+	"gopkg.in/yaml.v3"
+)
+
+// A recipe lists the steps of a codeplug build, so that a whole multi-source build can be
+// run with one invocation instead of a pipeline. Step keys have the same names as the
+// corresponding command line flags, for example:
+//
+//	in: base.codeplug.yaml
+//	out: maine.codeplug.yaml
+//	steps:
+//	  - ds: RADIOID_DMR
+//	    f: ['band=2m,70cm', 'state=Maine']
+//	    zone: 'ME $city:6 $callsign'
+//	  - ds: REPEATERBOOK_FM
+//	    f: ['band=2m,70cm', 'state=Maine']
+//	    zone: 'ME Analog'
+
+type Recipe struct {
+	In    string       `yaml:"in"`  // Input codeplug, relative to the recipe file
+	Out   string       `yaml:"out"` // Output codeplug, relative to the recipe file
+	Steps []RecipeStep `yaml:"steps"`
+}
+
+// RecipeStep holds the options for one query. Options that aren't set use the command line value.
+type RecipeStep struct {
+	Name               string         `yaml:"name"`
+	Datasource         *string        `yaml:"ds"`
+	Filters            []string       `yaml:"f"`
+	ZonePattern        *string        `yaml:"zone"`
+	GLPattern          *string        `yaml:"gl"`
+	ChannelPattern     *string        `yaml:"ch"`
+	Power              *string        `yaml:"power"`
+	TalkgroupsRequired *bool          `yaml:"tg"`
+	NARepeaterBookDB   *bool          `yaml:"na"`
+	NameLength         *int           `yaml:"name_lim"`
+	Open               *bool          `yaml:"open"`
+	OnAir              *bool          `yaml:"on_air"`
+	Locations          *locationFlags `yaml:"loc"`
+	Radius             *float64       `yaml:"radius"`
+	RadiusUnits        *string        `yaml:"units"`
+	SortOrder          *string        `yaml:"sort"`
+	Limit              *int           `yaml:"limit"`
+}
+
+func LoadRecipe(fileName string) (*Recipe, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	var recipe Recipe
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	err = decoder.Decode(&recipe)
+	if err != nil {
+		return nil, err
+	}
+	if len(recipe.Steps) == 0 {
+		return nil, fmt.Errorf("recipe has no steps")
+	}
+	dir := filepath.Dir(fileName)
+	if recipe.In != "" && !filepath.IsAbs(recipe.In) {
+		recipe.In = filepath.Join(dir, recipe.In)
+	}
+	if recipe.Out != "" && !filepath.IsAbs(recipe.Out) {
+		recipe.Out = filepath.Join(dir, recipe.Out)
+	}
+	for i, step := range recipe.Steps {
+		var ff filter.Filters
+		for _, f := range step.Filters {
+			err = ff.Set(f)
+			if err != nil {
+				return nil, fmt.Errorf("step %d: %v", i+1, err)
+			}
+		}
+	}
+	return &recipe, nil
+}
+
+// runRecipe applies each recipe step to the codeplug in order
+func runRecipe(ctx context.Context, recipe *Recipe, cp *codeplug.Codeplug) {
+	defaults := saveQueryOptions()
+	// Check all the steps before running any queries
+	for i, step := range recipe.Steps {
+		defaults.restore()
+		step.apply()
+		err := checkQueryOptions()
+		if err != nil {
+			fatal("recipe step %d %s: %v", i+1, step.Name, err)
+		}
+	}
+	for i, step := range recipe.Steps {
+		defaults.restore()
+		step.apply()
+		logger.Debug("recipe step", "step", i+1, "name", step.Name)
+		checkQueryOptions()
+		q := fillCodeplug(ctx, cp)
+		q.Step = step.Name
+		report.Queries = append(report.Queries, q)
+	}
+	// Generated zones are arranged using the command line options
+	defaults.restore()
+}
+
+func (s RecipeStep) apply() {
+	setIfPresent(&datasource, s.Datasource)
+	for _, f := range s.Filters {
+		// Filters were checked when the recipe was loaded
+		filters.Set(f)
+	}
+	setIfPresent(&zonePattern, s.ZonePattern)
+	setIfPresent(&glPattern, s.GLPattern)
+	setIfPresent(&channelPattern, s.ChannelPattern)
+	setIfPresent(&power, s.Power)
+	setIfPresent(&talkgroupsRequired, s.TalkgroupsRequired)
+	setIfPresent(&naRepeaterBookDB, s.NARepeaterBookDB)
+	setIfPresent(&nameLength, s.NameLength)
+	setIfPresent(&open, s.Open)
+	setIfPresent(&onAir, s.OnAir)
+	setIfPresent(&locations, s.Locations)
+	setIfPresent(&radius, s.Radius)
+	setIfPresent(&radiusUnits, s.RadiusUnits)
+	setIfPresent(&sortOrder, s.SortOrder)
+	setIfPresent(&limit, s.Limit)
+}
+
+func setIfPresent[T any](option *T, value *T) {
+	if value != nil {
+		*option = *value
+	}
+}
+
+// queryOptions holds the command line values of the options a recipe step can change
+type queryOptions struct {
+	datasource         string
+	filters            filter.Filters
+	zonePattern        string
+	glPattern          string
+	channelPattern     string
+	power              string
+	talkgroupsRequired bool
+	naRepeaterBookDB   bool
+	nameLength         int
+	open               bool
+	onAir              bool
+	locations          locationFlags
+	radius             float64
+	radiusUnits        string
+	sortOrder          string
+	limit              int
+}
+
+func saveQueryOptions() queryOptions {
+	return queryOptions{
+		datasource:         datasource,
+		filters:            slices.Clone(filters),
+		zonePattern:        zonePattern,
+		glPattern:          glPattern,
+		channelPattern:     channelPattern,
+		power:              power,
+		talkgroupsRequired: talkgroupsRequired,
+		naRepeaterBookDB:   naRepeaterBookDB,
+		nameLength:         nameLength,
+		open:               open,
+		onAir:              onAir,
+		locations:          slices.Clone(locations),
+		radius:             radius,
+		radiusUnits:        radiusUnits,
+		sortOrder:          sortOrder,
+		limit:              limit,
+	}
+}
+
+func (o queryOptions) restore() {
+	datasource = o.datasource
+	filters = slices.Clone(o.filters)
+	zonePattern = o.zonePattern
+	glPattern = o.glPattern
+	channelPattern = o.channelPattern
+	power = o.power
+	talkgroupsRequired = o.talkgroupsRequired
+	naRepeaterBookDB = o.naRepeaterBookDB
+	nameLength = o.nameLength
+	open = o.open
+	onAir = o.onAir
+	locations = slices.Clone(o.locations)
+	radius = o.radius
+	radiusUnits = o.radiusUnits
+	sortOrder = o.sortOrder
+	limit = o.limit
+}
@@ -0,0 +1,637 @@
+// Package repeaterbook is a client for the RepeaterBook repeater directory API
+package repeaterbook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jancona/dmrfill/api"
+	"github.com/jancona/dmrfill/band"
+	"github.com/jancona/dmrfill/filter"
+	"github.com/jancona/dmrfill/geo"
+)
+
+// API Doc: https://www.repeaterbook.com/wiki/doku.php?id=api
+// Examples: https://www.repeaterbook.com/api/export.php?state=Maine&county=Cumberland
+//           https://www.repeaterbook.com/api/export.php?qtype=prox&dunit=km&lat=44.551&lng=-69.632&dist=40
+
+const (
+	// DefaultBaseURL is the North American database, covering the US, Canada and Mexico
+	DefaultBaseURL = "https://www.repeaterbook.com/api/export.php"
+	// DefaultROWBaseURL is the database for the rest of the world
+	DefaultROWBaseURL = "https://www.repeaterbook.com/api/exportROW.php"
+)
+
+var (
+	// ErrTokenRequired is returned when RepeaterBook refuses a request without an API token
+	ErrTokenRequired = errors.New("RepeaterBook requires an API token")
+	// ErrTokenRejected is returned when RepeaterBook refuses the API token
+	ErrTokenRejected = errors.New("RepeaterBook rejected the API token")
+	// ErrRateLimited is returned when there have been too many requests
+	ErrRateLimited = errors.New("RepeaterBook rate limit exceeded")
+)
+
+// Supported query parameters
+var queryParamNames = map[string]struct{}{
+	"callsign":  {}, // Repeater callsign
+	"city":      {}, // Repeater city
+	"landmark":  {}, //
+	"state":     {}, // State / Province
+	"country":   {}, // Repeater country
+	"county":    {}, // Repeater county
+	"frequency": {}, // Repeater frequency
+	"mode":      {}, // Repeater operating mode (analog, DMR, NXDN, P25, tetra)
+	"emcomm":    {}, // ARES, RACES, SKYWARN, CANWARN
+	"stype":     {}, // Service type. Only required when searching for GMRS repeaters. ex: stype=gmrs
+}
+
+// Supported proximity query parameters
+var proxQueryParamNames = map[string]struct{}{
+	"qtype": {}, // Proximity search "prox"
+	"lat":   {}, // Proximity search latitude
+	"lng":   {}, // Proximity search longitude
+	"dist":  {}, // Proximity search distance
+	"dunit": {}, // Proximity search distance units (km, ?)
+}
+
+// fields maps filter keys to Repeater field names, or to "" for computed fields
+var fields = map[string]string{}
+
+// Put the Repeater JSON field names in a map
+func init() {
+	st := reflect.TypeOf(Repeater{})
+	for i := 0; i < st.NumField(); i++ {
+		field := st.Field(i)
+		tag := strings.Split(field.Tag.Get("json"), ",")[0]
+		if !field.IsExported() || tag == "-" {
+			continue
+		}
+		if tag != "" {
+			fields[strings.ToLower(tag)] = field.Name
+		} else {
+			fields[strings.ToLower(field.Name)] = field.Name
+		}
+	}
+	// Allow the same field name as RadioID
+	fields["city"] = "NearestCity"
+	fields["network"] = "" // computed
+	fields["has"] = ""     // computed
+	fields["mode"] = ""    // computed
+}
+
+// Geocoder finds the centers of proximity searches
+type Geocoder interface {
+	Geocode(ctx context.Context, place string) (geo.Point, error)
+}
+
+// Options configures a Client
+type Options struct {
+	HTTPClient *http.Client // default api.DefaultClient
+	BaseURL    string       // North American database, default DefaultBaseURL
+	ROWBaseURL string       // Rest of the world database, default DefaultROWBaseURL
+	Token      string       // API token, sent as a bearer token
+	UserAgent  string
+	Geocoder   Geocoder     // required for proximity searches
+	Logger     *slog.Logger // default no logging
+	// MaxConcurrent limits the number of concurrent requests, default 4
+	MaxConcurrent int
+	// RequestInterval is the minimum time between the starts of requests, default 250ms
+	RequestInterval time.Duration
+}
+
+// Query describes the repeaters to search for
+type Query struct {
+	// Filters on fields that RepeaterBook supports as query parameters are sent with the
+	// request, the others are applied to the results
+	Filters filter.Filters
+	// RestOfWorld queries the database for outside the US, Canada and Mexico
+	RestOfWorld bool
+	// Only return open repeaters
+	Open bool
+	// Only return on-air repeaters
+	OnAir bool
+	// Locations to do proximity searches around, if empty there's no proximity search
+	Locations []Location
+}
+
+// Location is the center of a proximity search
+type Location struct {
+	Place    string // Geocoded to find the center
+	RadiusKm float64
+}
+
+// Client queries RepeaterBook
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	rowBaseURL string
+	token      string
+	userAgent  string
+	geocoder   Geocoder
+	logger     *slog.Logger
+	limiter    *api.Limiter
+}
+
+// NewClient returns a client configured by opts
+func NewClient(opts Options) *Client {
+	c := &Client{
+		httpClient: opts.HTTPClient,
+		baseURL:    opts.BaseURL,
+		rowBaseURL: opts.ROWBaseURL,
+		token:      opts.Token,
+		userAgent:  opts.UserAgent,
+		geocoder:   opts.Geocoder,
+		logger:     api.Logger(opts.Logger),
+	}
+	if c.httpClient == nil {
+		c.httpClient = api.DefaultClient
+	}
+	if c.baseURL == "" {
+		c.baseURL = DefaultBaseURL
+	}
+	if c.rowBaseURL == "" {
+		c.rowBaseURL = DefaultROWBaseURL
+	}
+	if opts.MaxConcurrent <= 0 {
+		opts.MaxConcurrent = 4
+	}
+	if opts.RequestInterval <= 0 {
+		opts.RequestInterval = 250 * time.Millisecond
+	}
+	c.limiter = api.NewLimiter(opts.MaxConcurrent, opts.RequestInterval)
+	return c
+}
+
+// Query returns the repeaters that match the query. A filter with several values, or several
+// proximity search locations, may make several requests, whose results are merged.
+func (c *Client) Query(ctx context.Context, q Query) (*Results, error) {
+	base := c.baseURL
+	if q.RestOfWorld {
+		base = c.rowBaseURL
+	}
+	filters := slices.Clone(q.Filters)
+	if q.Open {
+		filters.Set("use=OPEN")
+	}
+	if q.OnAir {
+		filters.Set("operational_status=On-air")
+	}
+	var queries []func() (*Results, error)
+	if len(q.Locations) == 0 {
+		paramSets, resultFilters := c.params(filters, queryParamNames)
+		for _, params := range paramSets {
+			queries = append(queries, func() (*Results, error) {
+				result, err := c.fetch(ctx, base, params)
+				if err != nil {
+					return nil, err
+				}
+				return c.filterResults(ctx, result, resultFilters), nil
+			})
+		}
+	} else {
+		if c.geocoder == nil {
+			return nil, errors.New("proximity search requires a Geocoder")
+		}
+		// Do a proximity search around each location
+		for _, loc := range q.Locations {
+			queries = append(queries, func() (*Results, error) {
+				return c.queryProximity(ctx, base, filters, loc)
+			})
+		}
+	}
+	results, err := runAll(queries)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 1 {
+		return results[0], nil
+	}
+	return c.merge(results), nil
+}
+
+// queryProximity searches for repeaters around one location
+func (c *Client) queryProximity(ctx context.Context, base string, filters filter.Filters, loc Location) (*Results, error) {
+	point, err := c.geocoder.Geocode(ctx, loc.Place)
+	if err != nil {
+		return nil, fmt.Errorf("error geocoding location '%s': %w", loc.Place, err)
+	}
+	center := geo.Center{
+		Name:  loc.Place,
+		Point: point,
+	}
+	filters = slices.Clone(filters)
+	filters.Set("qtype=prox")
+	filters.Set("dunit=km")
+	filters.Set(fmt.Sprintf("dist=%f", loc.RadiusKm))
+	filters.Set("lat=" + strconv.FormatFloat(point.Lat, 'f', -1, 64))
+	filters.Set("lng=" + strconv.FormatFloat(point.Lng, 'f', -1, 64))
+	paramSets, resultFilters := c.params(filters, proxQueryParamNames)
+	result, err := c.fetch(ctx, base, paramSets[0])
+	if err != nil {
+		return nil, err
+	}
+	result = c.filterResults(ctx, result, resultFilters)
+	for i := range result.Results {
+		result.Results[i].Center = &center
+	}
+	return result, nil
+}
+
+// maxQueryCombinations limits how many RepeaterBook queries one search can fan out to
+const maxQueryCombinations = 20
+
+// params splits the filters into sets of query parameters, using the parameter names in
+// queryParams, and filters to be applied to the results. RepeaterBook doesn't OR multiple
+// filter parameters, it just uses the last one, so a filter with several values, like
+// 'state=Maine,New Hampshire', becomes a query for each value. Filters with several values
+// are combined, up to maxQueryCombinations queries. Beyond that they're applied to the
+// results instead.
+func (c *Client) params(filters filter.Filters, queryParams map[string]struct{}) ([]url.Values, filter.Filters) {
+	// Filters to be applied on the results
+	var resultFilters filter.Filters
+
+	// Query params
+	paramSets := []url.Values{{}}
+	for _, f := range filters {
+		_, ok := queryParams[f.Key]
+		if ok && f.IsQuery() && len(paramSets)*len(f.Values) <= maxQueryCombinations {
+			var sets []url.Values
+			for _, params := range paramSets {
+				for _, v := range f.Values {
+					p := maps.Clone(params)
+					p.Set(f.Key, v)
+					sets = append(sets, p)
+				}
+			}
+			paramSets = sets
+		} else {
+			if ok && f.IsQuery() {
+				c.logger.Debug("too many query combinations, filtering results", "filter", f)
+			}
+			_, ok := fields[f.Key]
+			if ok {
+				resultFilters = append(resultFilters, f)
+			} else {
+				c.logger.Debug("ignoring filter on unknown field", "filter", f)
+			}
+		}
+	}
+	return paramSets, resultFilters
+}
+
+func (c *Client) fetch(ctx context.Context, base string, params url.Values) (*Results, error) {
+	baseURL, err := url.Parse(base)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing base URL %s: %v", base, err)
+	}
+	baseURL.RawQuery = params.Encode()
+	c.logger.Debug("RepeaterBook request", "url", baseURL.String())
+
+	req, err := api.NewRequest(ctx, baseURL.String(), c.userAgent)
+	if err != nil {
+		return nil, err
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	err = c.limiter.Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer c.limiter.Release()
+	body, err := api.Fetch(c.httpClient, req, c.logger)
+	if err != nil {
+		return nil, c.explain(err)
+	}
+	// Errors are sometimes reported with a 200 status
+	var status struct {
+		Status  string `json:"status"`
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(body, &status) == nil && status.Status == "error" {
+		return nil, c.explainMessage(status.Message)
+	}
+	result := Results{
+		Results: []Repeater{},
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing JSON response: %v", err)
+	}
+	c.logger.Debug("RepeaterBook results", "count", result.Count)
+	return &result, nil
+}
+
+// explain wraps errors that mean the request can't succeed as it is, like a rejected token or
+// too many requests, with ErrTokenRequired, ErrTokenRejected or ErrRateLimited
+func (c *Client) explain(err error) error {
+	var httpErr *api.HTTPError
+	if !errors.As(err, &httpErr) {
+		return err
+	}
+	switch httpErr.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		if c.token == "" {
+			return fmt.Errorf("%w: %w", ErrTokenRequired, err)
+		}
+		return fmt.Errorf("%w: %w", ErrTokenRejected, err)
+	case http.StatusTooManyRequests:
+		if httpErr.RetryAfter > 0 {
+			return fmt.Errorf("%w, retry after %v: %w", ErrRateLimited, httpErr.RetryAfter.Round(time.Second), err)
+		}
+		return fmt.Errorf("%w: %w", ErrRateLimited, err)
+	}
+	return err
+}
+
+// explainMessage returns the error for a message reported with a 200 status, wrapping token
+// and rate limit problems like explain does for the HTTP status
+func (c *Client) explainMessage(message string) error {
+	err := fmt.Errorf("RepeaterBook returned an error: %s", message)
+	m := strings.ToLower(message)
+	switch {
+	case strings.Contains(m, "rate limit") || strings.Contains(m, "too many") || strings.Contains(m, "limit exceeded"):
+		return fmt.Errorf("%w: %w", ErrRateLimited, err)
+	case strings.Contains(m, "token") || strings.Contains(m, "unauthorized") || strings.Contains(m, "api key"):
+		if c.token == "" {
+			return fmt.Errorf("%w: %w", ErrTokenRequired, err)
+		}
+		return fmt.Errorf("%w: %w", ErrTokenRejected, err)
+	}
+	return err
+}
+
+// filterResults does client filtering
+func (c *Client) filterResults(ctx context.Context, result *Results, resultFilters filter.Filters) *Results {
+	newResults := []Repeater{}
+	for _, r := range result.Results {
+		f, err := strconv.ParseFloat(r.Frequency, 64)
+		if err == nil {
+			r.Band = band.Name(f)
+		}
+		matchesAll := true
+		for _, rf := range resultFilters {
+			matches := rf.Matches(r, fields)
+			c.logger.Log(ctx, api.LevelTrace, "filter", "filter", rf, "repeater", r.Callsign, "matches", matches)
+			if !matches {
+				matchesAll = false
+				break
+			}
+		}
+		if matchesAll {
+			newResults = append(newResults, r)
+		}
+	}
+	result.Count = len(newResults)
+	result.Results = newResults
+	c.logger.Debug("RepeaterBook results after filtering", "count", result.Count)
+	return result
+}
+
+// merge combines results, removing duplicates by StateID and RptrID. A repeater found by
+// more than one proximity search is assigned to the nearest center.
+func (c *Client) merge(results []*Results) *Results {
+	merged := Results{
+		Results: []Repeater{},
+	}
+	index := map[string]int{}
+	for _, result := range results {
+		for _, r := range result.Results {
+			key := r.StateID + "/" + strconv.Itoa(r.RptrID)
+			i, ok := index[key]
+			if !ok {
+				index[key] = len(merged.Results)
+				merged.Results = append(merged.Results, r)
+			} else if r.nearerCenter(merged.Results[i]) {
+				merged.Results[i].Center = r.Center
+			}
+		}
+	}
+	merged.Count = len(merged.Results)
+	c.logger.Debug("RepeaterBook results after merging", "count", merged.Count)
+	return &merged
+}
+
+// nearerCenter returns true if r's proximity search center is closer to it than other's
+func (r Repeater) nearerCenter(other Repeater) bool {
+	loc, ok := r.GetLocation()
+	if !ok || r.Center == nil || other.Center == nil {
+		return false
+	}
+	return geo.DistanceKm(r.Center.Point, loc) < geo.DistanceKm(other.Center.Point, loc)
+}
+
+// runAll runs the functions concurrently, returning their results in order and any errors joined
+func runAll[T any](funcs []func() (T, error)) ([]T, error) {
+	results := make([]T, len(funcs))
+	errs := make([]error, len(funcs))
+	var wg sync.WaitGroup
+	for i, f := range funcs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = f()
+		}()
+	}
+	wg.Wait()
+	return results, errors.Join(errs...)
+}
+
+type Results struct {
+	Count   int        `json:"count"`
+	Results []Repeater `json:"results"`
+}
+
+// Repeater is a RepeaterBook repeater
+type Repeater struct {
+	StateID           string `json:"State ID"`
+	RptrID            int    `json:"Rptr ID"`
+	Frequency         string `json:"Frequency"`
+	InputFreq         string `json:"Input Freq"`
+	PL                string `json:"PL"`
+	TSQ               string `json:"TSQ"`
+	NearestCity       string `json:"Nearest City"`
+	Landmark          string `json:"Landmark"`
+	Region            string `json:"Region"`
+	County            string `json:"County"`
+	State             string `json:"State"`
+	Country           string `json:"Country"`
+	Lat               string `json:"Lat"`
+	Long              string `json:"Long"`
+	Precise           int    `json:"Precise"`
+	Callsign          string `json:"Callsign"`
+	Use               string `json:"Use"`
+	OperationalStatus string `json:"Operational Status"`
+	Ares              string `json:"ARES"`
+	Races             string `json:"RACES"`
+	Skywarn           string `json:"SKYWARN"`
+	Canwarn           string `json:"CANWARN"`
+	AllStarNode       string `json:"AllStar Node"`
+	EchoLinkNode      string `json:"EchoLink Node"`
+	IRLPNode          string `json:"IRLP Node"`
+	WiresNode         string `json:"Wires Node"`
+	FMAnalog          string `json:"FM Analog"`
+	Dmr               string `json:"DMR"`
+	DMRColorCode      any    `json:"DMR Color Code"` // empty = "", else number
+	DMRID             any    `json:"DMR ID"`         // empty = "", else number
+	DStar             string `json:"D-Star"`
+	Nxdn              string `json:"NXDN"`
+	APCOP25           string `json:"APCO P-25"`
+	P25NAC            string `json:"P-25 NAC"`
+	M17               string `json:"M17"`
+	M17CAN            string `json:"M17 CAN"`
+	Tetra             string `json:"Tetra"`
+	TetraMCC          string `json:"Tetra MCC"`
+	TetraMNC          string `json:"Tetra MNC"`
+	SystemFusion      string `json:"System Fusion"`
+	YSFDGIDUplink     string `json:"YSF DG ID Uplink"`
+	YSFDGISDownlink   string `json:"YSF DG IS Downlink"`
+	YSFDSC            string `json:"YSF DSC"`
+	Notes             string `json:"Notes"`
+	LastUpdate        string `json:"Last Update"`
+	Band              string
+	Center            *geo.Center `json:"-"` // The proximity search center that found the repeater
+}
+
+func (r Repeater) GetCallsign() string {
+	return r.Callsign
+}
+func (r Repeater) GetCity() string {
+	return r.NearestCity
+}
+func (r Repeater) GetFrequency() string {
+	return r.Frequency
+}
+func (r Repeater) GetState() string {
+	return r.State
+}
+func (r Repeater) GetRegion() string {
+	return r.Region
+}
+func (r Repeater) GetCountry() string {
+	return r.Country
+}
+func (r Repeater) GetCounty() string {
+	return r.County
+}
+func (r Repeater) GetLandmark() string {
+	return r.Landmark
+}
+func (r Repeater) GetLocation() (geo.Point, bool) {
+	lat, err1 := strconv.ParseFloat(r.Lat, 64)
+	lng, err2 := strconv.ParseFloat(r.Long, 64)
+	return geo.Point{Lat: lat, Lng: lng}, err1 == nil && err2 == nil
+}
+func (r Repeater) GetCenter() (geo.Center, bool) {
+	if r.Center == nil {
+		return geo.Center{}, false
+	}
+	return *r.Center, true
+}
+func (r Repeater) GetOffset() (float64, bool) {
+	rx, err1 := strconv.ParseFloat(r.Frequency, 64)
+	tx, err2 := strconv.ParseFloat(r.InputFreq, 64)
+	return tx - rx, err1 == nil && err2 == nil
+}
+func (r Repeater) GetColorCode() string {
+	return filter.FieldString(reflect.ValueOf(r.DMRColorCode))
+}
+func (r Repeater) GetLastUpdate() time.Time {
+	t, _ := filter.ParseDate(r.LastUpdate)
+	return t
+}
+func (r Repeater) GetTone() string {
+	return r.PL
+}
+
+// GetNetwork returns the names of the networks the repeater is linked to, e.g. "AllStar/EchoLink"
+func (r Repeater) GetNetwork() string {
+	return strings.Join(r.linkedNetworks(), "/")
+}
+
+func (r Repeater) linkedNetworks() []string {
+	var networks []string
+	for _, n := range []struct {
+		name string
+		node string
+	}{
+		{"AllStar", r.AllStarNode},
+		{"EchoLink", r.EchoLinkNode},
+		{"IRLP", r.IRLPNode},
+		{"WIRES", r.WiresNode},
+	} {
+		if filter.IsSet(n.node) {
+			networks = append(networks, n.name)
+		}
+	}
+	return networks
+}
+
+// Features returns the 'has' filter values: linked networks (allstar, echolink, irlp, wires)
+// and emcomm affiliations (ares, races, skywarn, canwarn)
+func (r Repeater) Features() []string {
+	var features []string
+	for _, n := range r.linkedNetworks() {
+		features = append(features, strings.ToLower(n))
+	}
+	for _, e := range []struct {
+		name  string
+		value string
+	}{
+		{"ares", r.Ares},
+		{"races", r.Races},
+		{"skywarn", r.Skywarn},
+		{"canwarn", r.Canwarn},
+	} {
+		if filter.IsSet(e.value) {
+			features = append(features, e.name)
+		}
+	}
+	return features
+}
+
+// ComputedField returns the values of the computed filter fields
+func (r Repeater) ComputedField(key string) ([]string, bool) {
+	switch key {
+	case "has":
+		return r.Features(), true
+	case "network":
+		return r.linkedNetworks(), true
+	case "mode":
+		return r.Modes(), true
+	}
+	return nil, false
+}
+
+// Modes returns the 'mode' filter values, the same as the RepeaterBook mode query parameter,
+// so that proximity search results can be filtered by mode
+func (r Repeater) Modes() []string {
+	var modes []string
+	for _, m := range []struct {
+		name  string
+		value string
+	}{
+		{"analog", r.FMAnalog},
+		{"DMR", r.Dmr},
+		{"NXDN", r.Nxdn},
+		{"P25", r.APCOP25},
+		{"tetra", r.Tetra},
+	} {
+		if filter.IsSet(m.value) {
+			modes = append(modes, m.name)
+		}
+	}
+	return modes
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"os"
+
+	"github.com/jancona/dmrfill/codeplug"
+)
+
+// The run report is a JSON summary of what a run did, written with -report for CI jobs
+
+const (
+	reportOK     = "ok"
+	reportFailed = "failed"
+)
+
+type RunReport struct {
+	Status  string            `json:"status"` // ok or failed
+	Error   string            `json:"error,omitempty"`
+	Queries []QueryReport     `json:"queries"`
+	Skipped []SkippedRepeater `json:"skipped"`
+	Created CreatedCounts     `json:"created"`
+	Issues  []ValidationIssue `json:"issues,omitempty"`
+}
+
+// QueryReport describes one datasource query, the only one or a recipe step
+type QueryReport struct {
+	Step       string   `json:"step,omitempty"` // recipe step name
+	Datasource string   `json:"datasource"`
+	Filters    []string `json:"filters"`
+	Found      int      `json:"found"`   // repeaters matching the query
+	Limited    int      `json:"limited"` // repeaters left out by -limit
+	Added      int      `json:"added"`   // repeaters added to the codeplug
+}
+
+type SkippedRepeater struct {
+	Callsign  string `json:"callsign"`
+	Frequency string `json:"frequency"`
+	Reason    string `json:"reason"`
+}
+
+// CreatedCounts are the numbers of entities added to the codeplug
+type CreatedCounts struct {
+	Channels   int `json:"channels"`
+	Zones      int `json:"zones"`
+	Contacts   int `json:"contacts"`
+	GroupLists int `json:"groupLists"`
+}
+
+var report = RunReport{
+	Queries: []QueryReport{},
+	Skipped: []SkippedRepeater{},
+}
+
+// skipRepeater logs why a repeater isn't added and records it in the report
+func skipRepeater(level slog.Level, callsign, frequency, reason string) {
+	logger.Log(context.Background(), level, "skipping repeater", "callsign", callsign, "frequency", frequency, "reason", reason)
+	report.Skipped = append(report.Skipped, SkippedRepeater{Callsign: callsign, Frequency: frequency, Reason: reason})
+}
+
+// finish counts the entities that weren't in the input and writes the report
+func (r *RunReport) finish(cp *codeplug.Codeplug, inputIDs map[string]struct{}) {
+	count := func(items []codeplug.IDer) int {
+		n := 0
+		for _, e := range items {
+			if _, ok := inputIDs[e.GetID()]; !ok {
+				n++
+			}
+		}
+		return n
+	}
+	r.Created = CreatedCounts{
+		Channels:   count(codeplug.ToSliceOfIDer(cp.Channels)),
+		Zones:      count(codeplug.ToSliceOfIDer(cp.Zones)),
+		Contacts:   count(codeplug.ToSliceOfIDer(cp.Contacts)),
+		GroupLists: count(codeplug.ToSliceOfIDer(cp.GroupLists)),
+	}
+	r.Status = reportOK
+	r.write()
+}
+
+// fail writes the report for a run that stopped with an error
+func (r *RunReport) fail(msg string) {
+	r.Status = reportFailed
+	r.Error = msg
+	r.write()
+}
+
+func (r *RunReport) write() {
+	if reportFile == "" {
+		return
+	}
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err == nil {
+		err = os.WriteFile(reportFile, append(b, '\n'), 0644)
+	}
+	if err != nil {
+		// Not fatal, which would write the report again
+		logger.Error("unable to write report", "file", reportFile, "error", err)
+	}
+}
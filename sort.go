@@ -0,0 +1,102 @@
+package main
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jancona/dmrfill/codeplug"
+	"github.com/jancona/dmrfill/geo"
+)
+
+// Ordering of query results and generated zones
+
+const (
+	sortName       = "name"
+	sortDistance   = "distance"
+	sortFrequency  = "frequency"
+	sortCallsign   = "callsign"
+	sortLastUpdate = "last_update"
+)
+
+var sortOrders = []string{sortName, sortDistance, sortFrequency, sortCallsign, sortLastUpdate}
+
+type sortableRepeater interface {
+	RepeaterContext
+	GetLastUpdate() time.Time
+}
+
+// sortRepeaters orders the query results by -sort and keeps the first -limit of them. For
+// name order, names are formed using namePattern. Distances are from the proximity search
+// center, repeaters without a location are last. The most recently updated repeaters are first.
+func sortRepeaters[T sortableRepeater](repeaters []T, namePattern string) []T {
+	type keyed struct {
+		repeater  T
+		name      string
+		distance  float64
+		frequency float64
+	}
+	keys := make([]keyed, len(repeaters))
+	for i, r := range repeaters {
+		k := keyed{repeater: r, distance: math.Inf(1), frequency: ToFloat(r.GetFrequency())}
+		if sortOrder == sortName {
+			k.name = ReplaceArgs(namePattern, r, nil)
+		}
+		loc, ok := r.GetLocation()
+		center, found := r.GetCenter()
+		if ok && found {
+			k.distance = geo.DistanceKm(center.Point, loc)
+		}
+		keys[i] = k
+	}
+	slices.SortStableFunc(keys, func(a, b keyed) int {
+		switch sortOrder {
+		case sortDistance:
+			return cmp.Compare(a.distance, b.distance)
+		case sortFrequency:
+			return cmp.Compare(a.frequency, b.frequency)
+		case sortCallsign:
+			return strings.Compare(a.repeater.GetCallsign(), b.repeater.GetCallsign())
+		case sortLastUpdate:
+			return b.repeater.GetLastUpdate().Compare(a.repeater.GetLastUpdate())
+		default:
+			return cmp.Compare(a.name, b.name)
+		}
+	})
+	if limit > 0 && len(keys) > limit {
+		logger.Debug("limiting repeaters", "kept", limit, "found", len(keys))
+		keys = keys[:limit]
+	}
+	sorted := make([]T, len(keys))
+	for i, k := range keys {
+		sorted[i] = k.repeater
+	}
+	return sorted
+}
+
+// arrangeZones puts the generated zones after the zones from the input codeplug, which keep
+// their order. With name order, the generated zones and the channels in them are sorted by
+// name, otherwise they stay in the order of the query results.
+func arrangeZones(cp *codeplug.Codeplug, inputIDs map[string]struct{}) {
+	var input, generated []*codeplug.Zone
+	for _, z := range cp.Zones {
+		if _, ok := inputIDs[z.ID]; ok {
+			input = append(input, z)
+		} else {
+			generated = append(generated, z)
+		}
+	}
+	if sortOrder == sortName {
+		for _, z := range generated {
+			slices.SortStableFunc(z.A, func(a, b string) int {
+				return cmp.Compare(cp.ChannelName(a), cp.ChannelName(b))
+			})
+		}
+		slices.SortStableFunc(generated, func(a, b *codeplug.Zone) int {
+			return cmp.Compare(a.Name, b.Name)
+		})
+	}
+	cp.Zones = append(input, generated...)
+}
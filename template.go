@@ -0,0 +1,409 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/jancona/dmrfill/radioid"
+)
+
+// Naming patterns are a small template language:
+//
+//	$var, $var:width                 value of var, shortened to width
+//	${var:width|filter|filter...}    value of var with filters applied, then shortened to width
+//	${var=val1,val2?then:else}       then if var equals one of the values, else otherwise
+//	${var!=val?then:else}            then if var doesn't equal any of the values
+//	${var?then:else}                 then if var isn't empty
+//	$$                               a literal $
+//
+// The filters are upper, lower, title, trim, a printf format like %.3f and default:text.
+// The default text and the then and else branches are themselves patterns, e.g.
+// '${tg_name|default:TG $tg_number}'.
+
+// Pattern is a parsed naming pattern
+type Pattern struct {
+	nodes []patternNode
+}
+
+type patternNode interface {
+	expand(c RepeaterContext, tg *radioid.TalkGroup) namePart
+}
+
+// literal text in a pattern
+type literalNode struct {
+	text string
+}
+
+// a variable reference, $var or ${var...}
+type varNode struct {
+	name    string
+	width   int // 0 means no width
+	filters []patternFilter
+}
+
+// a conditional expression, ${var=val?then:else}
+type condNode struct {
+	name     string
+	op       string // "=", "!=" or "" to test for a non-empty value
+	values   []string
+	then     *Pattern
+	elseExpr *Pattern
+}
+
+type patternFilter func(val string, c RepeaterContext, tg *radioid.TalkGroup) string
+
+// patternVars are the variables that can be used in patterns
+var patternVars = map[string]struct{}{
+	"callsign":     {},
+	"city":         {},
+	"frequency":    {},
+	"state":        {},
+	"band":         {},
+	"state_code":   {},
+	"network":      {},
+	"county":       {},
+	"landmark":     {},
+	"country_code": {},
+	"distance":     {},
+	"bearing":      {},
+	"cc":           {},
+	"tone":         {},
+	"offset_sign":  {},
+	"center":       {},
+	"tg_name":      {},
+	"tg_number":    {},
+	"time_slot":    {},
+}
+
+var (
+	varRegex       = regexp.MustCompile(`^(\w+)(?::(\d+))?`)
+	varWidthRegex  = regexp.MustCompile(`^(\w+)(?::(\d+))?$`)
+	conditionRegex = regexp.MustCompile(`^\s*(\w+)\s*(?:(!=|=)(.*))?$`)
+	printfRegex    = regexp.MustCompile(`^%[-+# 0]*\d*(?:\.\d+)?([dfegsxXo])$`)
+)
+
+var patternCache = map[string]*Pattern{}
+
+// ParsePattern parses a naming pattern, returning an error describing the first syntax error
+func ParsePattern(s string) (*Pattern, error) {
+	if p, ok := patternCache[s]; ok {
+		return p, nil
+	}
+	p, err := parsePattern(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern '%s': %v", s, err)
+	}
+	patternCache[s] = p
+	return p, nil
+}
+
+func parsePattern(s string) (*Pattern, error) {
+	p := &Pattern{}
+	var lit strings.Builder
+	flush := func() {
+		if lit.Len() > 0 {
+			p.nodes = append(p.nodes, literalNode{text: lit.String()})
+			lit.Reset()
+		}
+	}
+	for i := 0; i < len(s); {
+		if s[i] != '$' {
+			lit.WriteByte(s[i])
+			i++
+			continue
+		}
+		rest := s[i+1:]
+		switch {
+		case strings.HasPrefix(rest, "$"):
+			lit.WriteByte('$')
+			i += 2
+		case strings.HasPrefix(rest, "{"):
+			end := closingBrace(rest[1:])
+			if end < 0 {
+				return nil, fmt.Errorf("missing } after '${' at position %d", i+1)
+			}
+			n, err := parseExpression(rest[1 : end+1])
+			if err != nil {
+				return nil, err
+			}
+			flush()
+			p.nodes = append(p.nodes, n)
+			i += end + 3
+		default:
+			m := varRegex.FindStringSubmatch(rest)
+			if m == nil {
+				return nil, fmt.Errorf("'$' at position %d must be followed by a variable name, '{' or '$'", i+1)
+			}
+			n, err := newVarNode(m[1], m[2])
+			if err != nil {
+				return nil, err
+			}
+			flush()
+			p.nodes = append(p.nodes, n)
+			i += 1 + len(m[0])
+		}
+	}
+	flush()
+	return p, nil
+}
+
+// closingBrace returns the index of the '}' that closes an expression starting at s[0],
+// allowing for nested ${...} expressions, or -1 if there isn't one
+func closingBrace(s string) int {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch {
+		case strings.HasPrefix(s[i:], "${"):
+			depth++
+			i++
+		case s[i] == '}':
+			if depth == 0 {
+				return i
+			}
+			depth--
+		}
+	}
+	return -1
+}
+
+// splitTop splits s at the first sep that isn't inside a nested ${...} expression
+func splitTop(s string, sep byte) (string, string, bool) {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch {
+		case strings.HasPrefix(s[i:], "${"):
+			depth++
+			i++
+		case s[i] == '}' && depth > 0:
+			depth--
+		case s[i] == sep && depth == 0:
+			return s[:i], s[i+1:], true
+		}
+	}
+	return s, "", false
+}
+
+func parseExpression(expr string) (patternNode, error) {
+	if cond, branches, ok := splitTop(expr, '?'); ok {
+		if m := conditionRegex.FindStringSubmatch(cond); m != nil {
+			return newCondNode(m, branches)
+		}
+	}
+	head, filters, _ := splitTop(expr, '|')
+	m := varWidthRegex.FindStringSubmatch(strings.TrimSpace(head))
+	if m == nil {
+		return nil, fmt.Errorf("invalid expression '${%s}'", expr)
+	}
+	n, err := newVarNode(m[1], m[2])
+	if err != nil {
+		return nil, err
+	}
+	for filters != "" {
+		var f string
+		f, filters, _ = splitTop(filters, '|')
+		pf, err := parsePatternFilter(f)
+		if err != nil {
+			return nil, err
+		}
+		n.filters = append(n.filters, pf)
+	}
+	return n, nil
+}
+
+func newVarNode(name, width string) (varNode, error) {
+	if _, ok := patternVars[name]; !ok {
+		return varNode{}, fmt.Errorf("unknown variable $%s", name)
+	}
+	n := varNode{name: name}
+	if width != "" {
+		w, err := strconv.Atoi(width)
+		if err != nil || w == 0 {
+			return varNode{}, fmt.Errorf("invalid width %s for $%s", width, name)
+		}
+		n.width = w
+	}
+	return n, nil
+}
+
+func newCondNode(m []string, branches string) (patternNode, error) {
+	if _, ok := patternVars[m[1]]; !ok {
+		return nil, fmt.Errorf("unknown variable $%s", m[1])
+	}
+	n := condNode{name: m[1], op: m[2]}
+	if n.op != "" {
+		for _, v := range strings.Split(m[3], ",") {
+			n.values = append(n.values, strings.TrimSpace(v))
+		}
+	}
+	thenExpr, elseExpr, _ := splitTop(branches, ':')
+	var err error
+	n.then, err = parsePattern(thenExpr)
+	if err != nil {
+		return nil, err
+	}
+	n.elseExpr, err = parsePattern(elseExpr)
+	if err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+func parsePatternFilter(f string) (patternFilter, error) {
+	name := strings.TrimSpace(f)
+	switch {
+	case name == "upper":
+		return func(val string, _ RepeaterContext, _ *radioid.TalkGroup) string { return strings.ToUpper(val) }, nil
+	case name == "lower":
+		return func(val string, _ RepeaterContext, _ *radioid.TalkGroup) string { return strings.ToLower(val) }, nil
+	case name == "title":
+		return func(val string, _ RepeaterContext, _ *radioid.TalkGroup) string { return titleCase(val) }, nil
+	case name == "trim":
+		return func(val string, _ RepeaterContext, _ *radioid.TalkGroup) string { return squeeze(val) }, nil
+	case strings.HasPrefix(name, "%"):
+		m := printfRegex.FindStringSubmatch(name)
+		if m == nil {
+			return nil, fmt.Errorf("invalid format '%s'", name)
+		}
+		return func(val string, _ RepeaterContext, _ *radioid.TalkGroup) string { return formatValue(name, m[1], val) }, nil
+	case strings.HasPrefix(strings.TrimLeftFunc(f, unicode.IsSpace), "default:"):
+		// The default text isn't trimmed so it can contain spaces
+		d, err := parsePattern(strings.TrimPrefix(strings.TrimLeftFunc(f, unicode.IsSpace), "default:"))
+		if err != nil {
+			return nil, err
+		}
+		return func(val string, c RepeaterContext, tg *radioid.TalkGroup) string {
+			if val == "" {
+				return d.String(c, tg)
+			}
+			return val
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown filter '%s'", name)
+}
+
+// formatValue formats val with a printf format, converting it to a number for numeric verbs.
+// Values that aren't numbers are left alone.
+func formatValue(format, verb, val string) string {
+	switch verb {
+	case "s":
+		return fmt.Sprintf(format, val)
+	case "d", "x", "X", "o":
+		f, err := strconv.ParseFloat(val, 64)
+		if err != nil {
+			return val
+		}
+		return fmt.Sprintf(format, int64(f))
+	default:
+		f, err := strconv.ParseFloat(val, 64)
+		if err != nil {
+			return val
+		}
+		return fmt.Sprintf(format, f)
+	}
+}
+
+// titleCase capitalizes the first letter of each word and lower cases the rest
+func titleCase(s string) string {
+	var b strings.Builder
+	start := true
+	for _, r := range s {
+		if start {
+			b.WriteRune(unicode.ToUpper(r))
+		} else {
+			b.WriteRune(unicode.ToLower(r))
+		}
+		start = unicode.IsSpace(r) || r == '-'
+	}
+	return b.String()
+}
+
+// patternHasVars returns true if the pattern uses any variables. Invalid patterns are treated as
+// literal text.
+func patternHasVars(s string) bool {
+	p, err := ParsePattern(s)
+	if err != nil {
+		return false
+	}
+	for _, n := range p.nodes {
+		if _, ok := n.(literalNode); !ok {
+			return true
+		}
+	}
+	return false
+}
+
+// patternText returns the text of a pattern that doesn't use any variables, with $$ replaced by $
+func patternText(s string) string {
+	p, err := ParsePattern(s)
+	if err != nil {
+		return s
+	}
+	var b strings.Builder
+	for _, part := range p.expand(nil, nil) {
+		b.WriteString(part.text)
+	}
+	return b.String()
+}
+
+// expand returns the parts of the name, which ReplaceArgs fits into the name length
+func (p *Pattern) expand(c RepeaterContext, tg *radioid.TalkGroup) []namePart {
+	parts := make([]namePart, 0, len(p.nodes))
+	for _, n := range p.nodes {
+		parts = append(parts, n.expand(c, tg))
+	}
+	return parts
+}
+
+// String returns the pattern expanded without any length limit
+func (p *Pattern) String(c RepeaterContext, tg *radioid.TalkGroup) string {
+	var b strings.Builder
+	for _, part := range p.expand(c, tg) {
+		b.WriteString(part.text)
+	}
+	return b.String()
+}
+
+func (n literalNode) expand(c RepeaterContext, tg *radioid.TalkGroup) namePart {
+	return namePart{text: displayName(n.text)}
+}
+
+func (n varNode) expand(c RepeaterContext, tg *radioid.TalkGroup) namePart {
+	val := displayName(lookupVar(n.name, c, tg))
+	for _, f := range n.filters {
+		val = f(val, c, tg)
+	}
+	if n.width > 0 {
+		if shortenMode == shortenSmart {
+			val = shorten(val, n.width)
+		} else {
+			val = truncate(val, n.width)
+		}
+	}
+	return namePart{text: val, variable: true}
+}
+
+func (n condNode) expand(c RepeaterContext, tg *radioid.TalkGroup) namePart {
+	val := lookupVar(n.name, c, tg)
+	var match bool
+	switch n.op {
+	case "=", "!=":
+		for _, v := range n.values {
+			if strings.EqualFold(v, val) {
+				match = true
+				break
+			}
+		}
+		if n.op == "!=" {
+			match = !match
+		}
+	default:
+		match = val != ""
+	}
+	if match {
+		return namePart{text: n.then.String(c, tg), variable: true}
+	}
+	return namePart{text: n.elseExpr.String(c, tg), variable: true}
+}
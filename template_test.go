@@ -0,0 +1,106 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/jancona/dmrfill/radioid"
+	"github.com/jancona/dmrfill/repeaterbook"
+)
+
+func TestPatternString(t *testing.T) {
+	saved := shortenMode
+	defer func() { shortenMode = saved }()
+	shortenMode = shortenSmart
+	repeater := repeaterbook.Repeater{
+		Callsign:    "W1IMD",
+		Frequency:   "145.180000",
+		InputFreq:   "144.580000",
+		NearestCity: "south portland",
+		State:       "Maine",
+		Country:     "United States",
+		PL:          "100.0",
+	}
+	tg := &radioid.TalkGroup{Number: 3181, TimeSlot: 1, Name: "New England Wide"}
+	tests := []struct {
+		pattern string
+		want    string
+	}{
+		{"Portland", "Portland"},
+		{"$callsign $city", "W1IMD south portland"},
+		{"$$$callsign", "$W1IMD"},
+		{"${callsign}x", "W1IMDx"},
+		{"$tg_name:6 $time_slot", "NwEng 1"},
+		{"${tg_name:6}", "NwEng"},
+		// Filters
+		{"${city|upper}", "SOUTH PORTLAND"},
+		{"${callsign|lower}", "w1imd"},
+		{"${city|title}", "South Portland"},
+		{"${landmark|trim}x", "x"},
+		{"${frequency|%.3f}", "145.180"},
+		{"${tg_number|%05d}", "03181"},
+		{"${tone|%d}", "100"},
+		{"${callsign|%d}", "W1IMD"},
+		{"${callsign|%-7s}|", "W1IMD  |"},
+		{"${landmark|default:none}", "none"},
+		{"${callsign|default:none}", "W1IMD"},
+		{"${city|title|upper}", "SOUTH PORTLAND"},
+		{"${city:8|title}", "S Prtlnd"},
+		// Conditionals
+		{"${state=Maine?ME:other}", "ME"},
+		{"${state=maine,NH?ME:other}", "ME"},
+		{"${state=NH, VT?northern:other}", "other"},
+		{"${state!=NH?not NH:NH}", "not NH"},
+		{"${state!=Maine?not ME}", ""},
+		{"${landmark?at $landmark:no landmark}", "no landmark"},
+		{"${callsign?$callsign}", "W1IMD"},
+		{"${offset_sign=-?minus:plus}", "minus"},
+		// Nesting
+		{"${landmark|default:${city|title}}", "South Portland"},
+		{"${landmark|default:TG $tg_number}", "TG 3181"},
+		{"${state=Maine?${landmark?$landmark:${callsign|lower}}:other}", "w1imd"},
+		{"${callsign?{$callsign}}", "{W1IMD}"},
+	}
+	for _, tt := range tests {
+		p, err := ParsePattern(tt.pattern)
+		if err != nil {
+			t.Errorf("ParsePattern(%q) returned error %v", tt.pattern, err)
+			continue
+		}
+		got := p.String(repeater, tg)
+		if got != tt.want {
+			t.Errorf("ParsePattern(%q).String() = %q, want %q", tt.pattern, got, tt.want)
+		}
+	}
+}
+
+func TestParsePatternErrors(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    string
+	}{
+		{"TG ${tg_name", "invalid pattern 'TG ${tg_name': missing } after '${' at position 4"},
+		{"${state=ME?${city:other}", "invalid pattern '${state=ME?${city:other}': missing } after '${' at position 1"},
+		{"50$ off", "invalid pattern '50$ off': '$' at position 3 must be followed by a variable name, '{' or '$'"},
+		{"$", "invalid pattern '$': '$' at position 1 must be followed by a variable name, '{' or '$'"},
+		{"${}", "invalid pattern '${}': invalid expression '${}'"},
+		{"${city:x}", "invalid pattern '${city:x}': invalid expression '${city:x}'"},
+		{"$town", "invalid pattern '$town': unknown variable $town"},
+		{"${town|upper}", "invalid pattern '${town|upper}': unknown variable $town"},
+		{"${town=Gray?G:X}", "invalid pattern '${town=Gray?G:X}': unknown variable $town"},
+		{"$city:0", "invalid pattern '$city:0': invalid width 0 for $city"},
+		{"${city:0}", "invalid pattern '${city:0}': invalid width 0 for $city"},
+		{"${frequency|%.3q}", "invalid pattern '${frequency|%.3q}': invalid format '%.3q'"},
+		{"${city|reverse}", "invalid pattern '${city|reverse}': unknown filter 'reverse'"},
+		{"${city|default:$town}", "invalid pattern '${city|default:$town}': unknown variable $town"},
+		{"${city?$town:x}", "invalid pattern '${city?$town:x}': unknown variable $town"},
+		{"${city?x:$}", "invalid pattern '${city?x:$}': '$' at position 1 must be followed by a variable name, '{' or '$'"},
+	}
+	for _, tt := range tests {
+		_, err := ParsePattern(tt.pattern)
+		if err == nil {
+			t.Errorf("ParsePattern(%q) didn't return an error", tt.pattern)
+		} else if err.Error() != tt.want {
+			t.Errorf("ParsePattern(%q) error = %q, want %q", tt.pattern, err, tt.want)
+		}
+	}
+}
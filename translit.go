@@ -0,0 +1,70 @@
+package main
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Most DMR radios can only display ASCII. With -ascii, accented Latin letters in generated
+// names are replaced by their base letters, e.g. München becomes Munchen, and other
+// characters that can't be displayed are dropped.
+
+// transliterations are the letters that don't decompose into a base letter and accents, and
+// punctuation with a close ASCII equivalent
+var transliterations = map[rune]string{
+	'Æ': "AE", 'æ': "ae",
+	'Ð': "D", 'ð': "d", 'Đ': "D", 'đ': "d",
+	'Ħ': "H", 'ħ': "h",
+	'ı': "i",
+	'Ĳ': "IJ", 'ĳ': "ij",
+	'ĸ': "k",
+	'Ŀ': "L", 'ŀ': "l", 'Ł': "L", 'ł': "l",
+	'ŉ': "n", 'Ŋ': "N", 'ŋ': "n",
+	'Ø': "O", 'ø': "o",
+	'Œ': "OE", 'œ': "oe",
+	'ß': "ss",
+	'Þ': "TH", 'þ': "th",
+	'Ŧ': "T", 'ŧ': "t",
+	'‘': "'", '’': "'", '“': "\"", '”': "\"", '–': "-", '—': "-",
+	'\u00a0': " ", // no-break space
+}
+
+// foldAccents removes the accents from letters, e.g. Timișoara becomes Timisoara, and replaces
+// the ones in transliterations. Letters of other scripts, like Greek and Cyrillic, are kept.
+func foldAccents(s string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(s) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			// An accent
+		case transliterations[r] != "":
+			b.WriteString(transliterations[r])
+		default:
+			b.WriteRune(r)
+		}
+	}
+	// Recompose the letters of scripts like Hangul, which decompose without marks
+	return norm.NFC.String(b.String())
+}
+
+// toASCII transliterates s to printable ASCII
+func toASCII(s string) string {
+	var b strings.Builder
+	for _, r := range foldAccents(s) {
+		if r >= ' ' && r < utf8.RuneSelf && r != 0x7f {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// displayName returns s transliterated to ASCII if -ascii is set
+func displayName(s string) string {
+	if asciiNames {
+		return toASCII(s)
+	}
+	return s
+}
@@ -0,0 +1,50 @@
+package main
+
+import "testing"
+
+func TestToASCII(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"Portland", "Portland"},
+		{"München", "Munchen"},
+		{"Constanța Timișoara", "Constanta Timisoara"},
+		{"Ţara Şcheilor", "Tara Scheilor"},
+		{"Zürich", "Zurich"},
+		{"ZÜRICH", "ZURICH"},
+		{"São João", "Sao Joao"},
+		{"Dvůr Králové", "Dvur Kralove"},
+		{"Großenhain", "Grossenhain"},
+		{"Łódź", "Lodz"},
+		{"Ærø", "AEro"},
+		{"Þórshöfn", "THorshofn"},
+		{"Đakovo", "Dakovo"},
+		{"Sant’Agata – Nord", "Sant'Agata - Nord"},
+		{"Αθήνα", ""},
+		{"Köln Αθήνα", "Koln "},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := toASCII(tt.in); got != tt.want {
+			t.Errorf("toASCII(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestFoldAccents(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"Timișoara", "Timisoara"},
+		{"Łódzkie", "Lodzkie"},
+		{"Αθήνα", "Αθηνα"},
+		{"Йошкар-Ола", "Иошкар-Ола"},
+		{"서울", "서울"},
+		{"Portland", "Portland"},
+	}
+	for _, tt := range tests {
+		if got := foldAccents(tt.in); got != tt.want {
+			t.Errorf("foldAccents(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
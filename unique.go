@@ -0,0 +1,157 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/jancona/dmrfill/codeplug"
+)
+
+// Shortened names can collide, e.g. two Portland repeaters with similar callsigns. QDMR and
+// the radio CPS programs handle duplicate names badly, so after the codeplug is filled, generated
+// zones, group lists and channels whose names are already in use get a suffix. Generated
+// contacts get one when they're created, since channel names are built from them with $tg_name.
+// Names from the input codeplug are never changed.
+
+const (
+	uniqueNumber    = "number"
+	uniqueFrequency = "frequency"
+	uniqueCallsign  = "callsign"
+)
+
+// nameSource is what a generated entity was named with: the repeater, if it was named from one,
+// and the name length limit, which can differ between recipe steps
+type nameSource struct {
+	repeater   RepeaterContext
+	nameLength int
+}
+
+// nameSources records the nameSource of each generated zone, group list and channel
+var nameSources = map[string]nameSource{}
+
+type renamable interface {
+	codeplug.Named
+	SetName(name string)
+}
+
+// codeplugIDs returns the IDs of all the zones, group lists, channels and contacts
+func codeplugIDs(cp *codeplug.Codeplug) map[string]struct{} {
+	ids := map[string]struct{}{}
+	for _, s := range [][]codeplug.IDer{
+		codeplug.ToSliceOfIDer(cp.Zones),
+		codeplug.ToSliceOfIDer(cp.GroupLists),
+		codeplug.ToSliceOfIDer(cp.Channels),
+		codeplug.ToSliceOfIDer(cp.Contacts),
+	} {
+		for _, e := range s {
+			ids[e.GetID()] = struct{}{}
+		}
+	}
+	return ids
+}
+
+// makeNamesUnique renames generated zones, group lists and channels, i.e. those whose IDs aren't
+// in inputIDs, that have the same name as another entity of the same kind
+func makeNamesUnique(cp *codeplug.Codeplug, inputIDs map[string]struct{}) {
+	uniqueNames(cp.Zones, "zone", inputIDs)
+	uniqueNames(cp.GroupLists, "group list", inputIDs)
+	uniqueNames(cp.Channels, "channel", inputIDs)
+}
+
+// uniqueContactName returns name, or if another contact has it, a version that isn't used
+func uniqueContactName(cp *codeplug.Codeplug, name string) string {
+	used := map[string]bool{}
+	for _, c := range cp.Contacts {
+		used[c.GetName()] = true
+	}
+	if !used[name] {
+		return name
+	}
+	return disambiguate(name, nameSource{nameLength: nameLength}, used)
+}
+
+func uniqueNames[T renamable](entities []T, kind string, inputIDs map[string]struct{}) {
+	used := map[string]bool{}
+	for _, e := range entities {
+		if _, ok := inputIDs[e.GetID()]; ok {
+			used[e.GetName()] = true
+		}
+	}
+	for _, e := range entities {
+		if _, ok := inputIDs[e.GetID()]; ok {
+			continue
+		}
+		name := e.GetName()
+		if used[name] {
+			name = disambiguate(name, nameSources[e.GetID()], used)
+			logger.Debug("renaming duplicate", "kind", kind, "id", e.GetID(), "name", e.GetName(), "new_name", name)
+			e.SetName(name)
+		}
+		used[name] = true
+	}
+}
+
+// disambiguate returns a version of name that isn't used, with a suffix chosen by the -unique
+// strategy. If the strategy doesn't produce an unused name, a number is added.
+func disambiguate(name string, source nameSource, used map[string]bool) string {
+	limit := source.nameLength
+	if limit == 0 {
+		limit = nameLength
+	}
+	var suffixes []string
+	if source.repeater != nil {
+		switch uniqueStrategy {
+		case uniqueFrequency:
+			suffixes = frequencySuffixes(source.repeater.GetFrequency())
+		case uniqueCallsign:
+			suffixes = callsignSuffixes(source.repeater.GetCallsign())
+		}
+	}
+	for _, suffix := range suffixes {
+		n := withSuffix(name, suffix, limit)
+		if !used[n] {
+			return n
+		}
+	}
+	for i := 2; len(strconv.Itoa(i)) <= limit; i++ {
+		n := withSuffix(name, strconv.Itoa(i), limit)
+		if !used[n] {
+			return n
+		}
+	}
+	// The limit is too short for a unique name
+	return name
+}
+
+// withSuffix appends suffix to name, truncating name so the result fits in limit. If there's
+// no room for the name, the suffix is used alone, truncated to the limit.
+func withSuffix(name, suffix string, limit int) string {
+	room := limit - len([]rune(suffix)) - 1
+	if room <= 0 {
+		return truncate(suffix, limit)
+	}
+	return strings.TrimSpace(truncate(name, room)) + " " + suffix
+}
+
+// frequencySuffixes returns the frequency without trailing zeros, e.g. 145.18
+func frequencySuffixes(frequency string) []string {
+	f := ToFloat(frequency)
+	if f == 0 {
+		return nil
+	}
+	return []string{strconv.FormatFloat(f, 'f', -1, 64)}
+}
+
+// callsignSuffixes returns the letters after the callsign's digit, e.g. IMD for W1IMD, then the whole callsign
+func callsignSuffixes(callsign string) []string {
+	if callsign == "" {
+		return nil
+	}
+	var suffixes []string
+	i := strings.LastIndexFunc(callsign, unicode.IsDigit)
+	if i >= 0 && i < len(callsign)-1 {
+		suffixes = append(suffixes, callsign[i+1:])
+	}
+	return append(suffixes, callsign)
+}
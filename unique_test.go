@@ -0,0 +1,86 @@
+package main
+
+import (
+	"slices"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/jancona/dmrfill/repeaterbook"
+)
+
+func TestWithSuffix(t *testing.T) {
+	tests := []struct {
+		name, suffix string
+		limit        int
+		want         string
+	}{
+		{"Portland", "2", 16, "Portland 2"},
+		{"ME Portland W1IMD", "2", 16, "ME Portland W1 2"},
+		{"ME Portland ", "145.18", 16, "ME Portla 145.18"},
+		{"München Süd", "IMD", 10, "Münche IMD"},
+		{"AB", "1234567890", 5, "12345"},
+		{"AB", "123", 4, "123"},
+		{"AB", "1234", 4, "1234"},
+	}
+	for _, tt := range tests {
+		got := withSuffix(tt.name, tt.suffix, tt.limit)
+		if got != tt.want {
+			t.Errorf("withSuffix(%q, %q, %d) = %q, want %q", tt.name, tt.suffix, tt.limit, got, tt.want)
+		}
+		if utf8.RuneCountInString(got) > tt.limit {
+			t.Errorf("withSuffix(%q, %q, %d) = %q, which is longer than the limit", tt.name, tt.suffix, tt.limit, got)
+		}
+	}
+}
+
+func TestDisambiguate(t *testing.T) {
+	repeater := repeaterbook.Repeater{Callsign: "W1IMD", Frequency: "145.180000"}
+	tests := []struct {
+		strategy string
+		source   nameSource
+		used     []string
+		want     string
+	}{
+		{uniqueNumber, nameSource{repeater, 16}, []string{"Portland"}, "Portland 2"},
+		{uniqueNumber, nameSource{repeater, 16}, []string{"Portland", "Portland 2"}, "Portland 3"},
+		{uniqueFrequency, nameSource{repeater, 16}, []string{"Portland"}, "Portland 145.18"},
+		{uniqueFrequency, nameSource{repeater, 16}, []string{"Portland", "Portland 145.18"}, "Portland 2"},
+		{uniqueFrequency, nameSource{nameLength: 16}, []string{"Portland"}, "Portland 2"},
+		{uniqueCallsign, nameSource{repeater, 16}, []string{"Portland"}, "Portland IMD"},
+		{uniqueCallsign, nameSource{repeater, 16}, []string{"Portland", "Portland IMD"}, "Portland W1IMD"},
+		{uniqueCallsign, nameSource{repeater, 16}, []string{"Portland", "Portland IMD", "Portland W1IMD"}, "Portland 2"},
+		{uniqueCallsign, nameSource{repeater, 10}, []string{"Portland"}, "Portla IMD"},
+		{uniqueCallsign, nameSource{repeater, 4}, []string{"Portland"}, "IMD"},
+		{uniqueNumber, nameSource{nameLength: 1}, []string{"P", "2", "3", "4", "5", "6", "7", "8", "9"}, "P"},
+	}
+	defer func(s string) { uniqueStrategy = s }(uniqueStrategy)
+	for _, tt := range tests {
+		uniqueStrategy = tt.strategy
+		used := map[string]bool{}
+		for _, n := range tt.used {
+			used[n] = true
+		}
+		got := disambiguate(tt.used[0], tt.source, used)
+		if got != tt.want {
+			t.Errorf("%s: disambiguate(%q) with %v used = %q, want %q", tt.strategy, tt.used[0], tt.used, got, tt.want)
+		}
+	}
+}
+
+func TestCallsignSuffixes(t *testing.T) {
+	tests := []struct {
+		callsign string
+		want     []string
+	}{
+		{"W1IMD", []string{"IMD", "W1IMD"}},
+		{"KQ1L", []string{"L", "KQ1L"}},
+		{"DB0ABC", []string{"ABC", "DB0ABC"}},
+		{"N1", []string{"N1"}},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		if got := callsignSuffixes(tt.callsign); !slices.Equal(got, tt.want) {
+			t.Errorf("callsignSuffixes(%q) = %q, want %q", tt.callsign, got, tt.want)
+		}
+	}
+}
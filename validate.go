@@ -0,0 +1,326 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jancona/dmrfill/band"
+	"github.com/jancona/dmrfill/codeplug"
+)
+
+// Check a codeplug for problems that would cause QDMR or the radio to reject it
+
+const (
+	severityError   = "error"
+	severityWarning = "warning"
+)
+
+type ValidationIssue struct {
+	Severity string `json:"severity"`
+	Kind     string `json:"kind"` // zone, channel, contact, group list, etc.
+	ID       string `json:"id"`
+	Message  string `json:"message"`
+}
+
+func (vi ValidationIssue) String() string {
+	return fmt.Sprintf("%s: %s %s: %s", vi.Severity, vi.Kind, vi.ID, vi.Message)
+}
+
+// RadioProfile holds the capacity limits of a radio model. A zero limit means no limit.
+type RadioProfile struct {
+	Name            string
+	NameLength      int
+	Channels        int
+	Zones           int
+	ChannelsPerZone int
+	Contacts        int
+	GroupLists      int
+	ContactsPerList int
+}
+
+// radioProfiles are the limits QDMR enforces for each radio when it writes a codeplug, taken
+// from its device limit classes (https://github.com/hmatuschek/qdmr, lib/*_limits.cc).
+var radioProfiles = map[string]RadioProfile{
+	"generic": {Name: "generic"},
+	// AnyTone AT-D878UV, QDMR D878UVLimits
+	"d878uv": {Name: "d878uv", NameLength: 16, Channels: 4000, Zones: 250, ChannelsPerZone: 250,
+		Contacts: 10000, GroupLists: 250, ContactsPerList: 64},
+	// AnyTone AT-D578UV, QDMR D578UVLimits
+	"d578uv": {Name: "d578uv", NameLength: 16, Channels: 4000, Zones: 250, ChannelsPerZone: 250,
+		Contacts: 10000, GroupLists: 250, ContactsPerList: 64},
+	// TYT MD-UV390, QDMR UV390Limits. A zone holds 16 channels plus 48 in its extension.
+	"md-uv390": {Name: "md-uv390", NameLength: 16, Channels: 3000, Zones: 250, ChannelsPerZone: 64,
+		Contacts: 10000, GroupLists: 250, ContactsPerList: 32},
+	// Radioddity GD-77 with the factory firmware, QDMR GD77Limits
+	"gd77": {Name: "gd77", NameLength: 16, Channels: 1024, Zones: 250, ChannelsPerZone: 16,
+		Contacts: 1024, GroupLists: 76, ContactsPerList: 32},
+	// Baofeng/Radioddity RD-5R, QDMR RD5RLimits
+	"rd5r": {Name: "rd5r", NameLength: 16, Channels: 1024, Zones: 250, ChannelsPerZone: 16,
+		Contacts: 256, GroupLists: 64, ContactsPerList: 16},
+}
+
+func radioProfileNames() []string {
+	var names []string
+	for n := range radioProfiles {
+		names = append(names, n)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// ValidateCodeplug checks IDs and references between sections, names, frequencies, tones and
+// radio limits.
+// nameLimit is used for name lengths when the profile doesn't specify one.
+func ValidateCodeplug(cp *codeplug.Codeplug, profile RadioProfile, nameLimit int) []ValidationIssue {
+	var v validator
+	if profile.NameLength > 0 {
+		nameLimit = profile.NameLength
+	}
+
+	channelIDs := idSet(cp.Channels)
+	contactIDs := idSet(cp.Contacts)
+	groupListIDs := idSet(cp.GroupLists)
+	scanListIDs := idSet(cp.ScanLists)
+	positioningIDs := idSet(cp.Positioning)
+	radioIDs := idSet(cp.RadioIDs)
+	roamingChannelIDs := idSet(cp.RoamingChannels)
+	roamingZoneIDs := idSet(cp.RoamingZones)
+
+	v.checkIDsAndNames("zone", codeplug.ToSliceOfIDer(cp.Zones), nameLimit)
+	v.checkIDsAndNames("channel", codeplug.ToSliceOfIDer(cp.Channels), nameLimit)
+	v.checkIDsAndNames("contact", codeplug.ToSliceOfIDer(cp.Contacts), nameLimit)
+	v.checkIDsAndNames("group list", codeplug.ToSliceOfIDer(cp.GroupLists), nameLimit)
+	v.checkIDsAndNames("scan list", codeplug.ToSliceOfIDer(cp.ScanLists), nameLimit)
+	v.checkIDsAndNames("positioning", codeplug.ToSliceOfIDer(cp.Positioning), nameLimit)
+	v.checkIDsAndNames("radio ID", codeplug.ToSliceOfIDer(cp.RadioIDs), nameLimit)
+	v.checkIDsAndNames("roaming channel", codeplug.ToSliceOfIDer(cp.RoamingChannels), nameLimit)
+	v.checkIDsAndNames("roaming zone", codeplug.ToSliceOfIDer(cp.RoamingZones), nameLimit)
+	v.checkIDsAndNames("encryption key", codeplug.ToSliceOfIDer(cp.Commercial.EncryptionKeys), nameLimit)
+
+	v.checkRef("settings", "", "radio ID", cp.Settings.DefaultID, radioIDs)
+
+	for _, z := range cp.Zones {
+		if len(z.A) == 0 && len(z.B) == 0 {
+			v.add(severityError, "zone", z.ID, fmt.Sprintf("zone %q is empty", z.Name))
+		}
+		for _, id := range slices.Concat(z.A, z.B) {
+			v.checkRef("zone", z.ID, "channel", id, channelIDs)
+		}
+		if profile.ChannelsPerZone > 0 && max(len(z.A), len(z.B)) > profile.ChannelsPerZone {
+			v.add(severityError, "zone", z.ID, fmt.Sprintf("zone %q has more than %d channels", z.Name, profile.ChannelsPerZone))
+		}
+	}
+
+	for _, ch := range cp.Channels {
+		if ch.Analog.ID != "" {
+			a := ch.Analog
+			v.checkFrequency("channel", a.ID, "rxFrequency", a.RxFrequency)
+			v.checkFrequency("channel", a.ID, "txFrequency", a.TxFrequency)
+			v.checkTone(a.ID, "rxTone", a.RxTone)
+			v.checkTone(a.ID, "txTone", a.TxTone)
+			v.checkRef("channel", a.ID, "scan list", a.ScanList, scanListIDs)
+			v.checkRef("channel", a.ID, "positioning system", a.APRS, positioningIDs)
+		} else {
+			d := ch.Digital
+			v.checkFrequency("channel", d.ID, "rxFrequency", d.RxFrequency)
+			v.checkFrequency("channel", d.ID, "txFrequency", d.TxFrequency)
+			v.checkRef("channel", d.ID, "group list", d.GroupList, groupListIDs)
+			v.checkRef("channel", d.ID, "contact", d.Contact, contactIDs)
+			v.checkRef("channel", d.ID, "scan list", d.ScanList, scanListIDs)
+			v.checkRef("channel", d.ID, "positioning system", d.APRS, positioningIDs)
+			v.checkRef("channel", d.ID, "roaming zone", d.Roaming, roamingZoneIDs)
+			v.checkColorCode("channel", d.ID, d.ColorCode)
+			v.checkTimeSlot("channel", d.ID, d.TimeSlot)
+		}
+	}
+
+	for _, gl := range cp.GroupLists {
+		for _, id := range gl.Contacts {
+			v.checkRef("group list", gl.ID, "contact", id, contactIDs)
+		}
+		if profile.ContactsPerList > 0 && len(gl.Contacts) > profile.ContactsPerList {
+			v.add(severityError, "group list", gl.ID, fmt.Sprintf("group list %q has more than %d contacts", gl.Name, profile.ContactsPerList))
+		}
+	}
+
+	for _, sl := range cp.ScanLists {
+		for _, id := range slices.Concat(sl.Channels, []string{sl.Primary, sl.Secondary, sl.Revert}) {
+			v.checkRef("scan list", sl.ID, "channel", id, channelIDs)
+		}
+	}
+
+	for _, p := range cp.Positioning {
+		switch {
+		case p.DMR != nil:
+			v.checkRef("positioning", p.DMR.ID, "contact", p.DMR.Contact, contactIDs)
+			v.checkRef("positioning", p.DMR.ID, "channel", p.DMR.Revert, channelIDs)
+		case p.APRS != nil:
+			v.checkRef("positioning", p.APRS.ID, "channel", p.APRS.Revert, channelIDs)
+		default:
+			v.add(severityWarning, "positioning", "", "unknown positioning system type")
+		}
+	}
+
+	for _, rc := range cp.RoamingChannels {
+		v.checkFrequency("roaming channel", rc.ID, "rxFrequency", rc.RxFrequency)
+		v.checkFrequency("roaming channel", rc.ID, "txFrequency", rc.TxFrequency)
+		if rc.ColorCode.HasValue {
+			v.checkColorCode("roaming channel", rc.ID, rc.ColorCode.Value)
+		}
+		if rc.TimeSlot.HasValue {
+			v.checkTimeSlot("roaming channel", rc.ID, rc.TimeSlot.Value)
+		}
+	}
+
+	for _, rz := range cp.RoamingZones {
+		for _, id := range rz.Channels {
+			v.checkRef("roaming zone", rz.ID, "roaming channel", id, roamingChannelIDs)
+		}
+	}
+
+	v.checkCount("channels", len(cp.Channels), profile.Channels)
+	v.checkCount("zones", len(cp.Zones), profile.Zones)
+	v.checkCount("contacts", len(cp.Contacts), profile.Contacts)
+	v.checkCount("group lists", len(cp.GroupLists), profile.GroupLists)
+	return v.issues
+}
+
+type validator struct {
+	issues []ValidationIssue
+}
+
+func (v *validator) add(severity, kind, id, message string) {
+	v.issues = append(v.issues, ValidationIssue{Severity: severity, Kind: kind, ID: id, Message: message})
+}
+
+func (v *validator) checkIDsAndNames(kind string, items []codeplug.IDer, nameLimit int) {
+	ids := map[string]struct{}{}
+	names := map[string]string{}
+	for _, item := range items {
+		id := item.GetID()
+		if id == "" {
+			v.add(severityError, kind, id, "missing id")
+		} else if _, ok := ids[id]; ok {
+			v.add(severityError, kind, id, "duplicate id")
+		}
+		ids[id] = struct{}{}
+		n, ok := item.(codeplug.Named)
+		if !ok {
+			continue
+		}
+		name := n.GetName()
+		if name == "" {
+			v.add(severityError, kind, id, "empty name")
+			continue
+		}
+		if other, ok := names[name]; ok {
+			v.add(severityError, kind, id, fmt.Sprintf("name %q duplicates %s", name, other))
+		} else {
+			names[name] = id
+		}
+		if nameLimit > 0 && utf8.RuneCountInString(name) > nameLimit {
+			v.add(severityError, kind, id, fmt.Sprintf("name %q is longer than %d characters", name, nameLimit))
+		}
+	}
+}
+
+func (v *validator) checkRef(kind, id, refKind, ref string, ids map[string]struct{}) {
+	if ref == "" {
+		return
+	}
+	if _, ok := ids[ref]; !ok {
+		v.add(severityError, kind, id, fmt.Sprintf("%s %s does not exist", refKind, ref))
+	}
+}
+
+func (v *validator) checkFrequency(kind, id, field, freq string) {
+	f, err := parseFrequency(freq)
+	if err != nil {
+		v.add(severityError, kind, id, fmt.Sprintf("invalid %s %q: %v", field, freq, err))
+		return
+	}
+	if band.Name(f) == "UNK" {
+		v.add(severityWarning, kind, id, fmt.Sprintf("%s %s is outside the amateur bands", field, freq))
+	}
+}
+
+func (v *validator) checkColorCode(kind, id string, cc int) {
+	if cc < 0 || cc > 15 {
+		v.add(severityError, kind, id, fmt.Sprintf("invalid color code %d", cc))
+	}
+}
+
+func (v *validator) checkTimeSlot(kind, id, ts string) {
+	if ts != "TS1" && ts != "TS2" {
+		v.add(severityError, kind, id, fmt.Sprintf("invalid time slot %q", ts))
+	}
+}
+
+func (v *validator) checkTone(id, field string, t codeplug.Tone) {
+	if t.CTCSS != 0 && !slices.Contains(ctcssTones, t.CTCSS) {
+		v.add(severityError, "channel", id, fmt.Sprintf("invalid %s CTCSS tone %.1f", field, t.CTCSS))
+	}
+	if t.DCS != 0 && !slices.Contains(dcsCodes, int(t.DCS)) {
+		v.add(severityError, "channel", id, fmt.Sprintf("invalid %s DCS code %g", field, t.DCS))
+	}
+}
+
+func (v *validator) checkCount(kind string, count, limit int) {
+	if limit > 0 && count > limit {
+		v.add(severityError, "codeplug", "", fmt.Sprintf("%d %s is more than the radio limit of %d", count, kind, limit))
+	}
+}
+
+func idSet[T codeplug.IDer](items []T) map[string]struct{} {
+	ids := map[string]struct{}{}
+	for _, item := range items {
+		ids[item.GetID()] = struct{}{}
+	}
+	return ids
+}
+
+// parseFrequency parses QDMR frequencies like "145.180000 MHz" and returns MHz
+func parseFrequency(freq string) (float64, error) {
+	fields := strings.Fields(freq)
+	if len(fields) == 0 || len(fields) > 2 {
+		return 0, fmt.Errorf("bad frequency format")
+	}
+	f, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, err
+	}
+	if len(fields) == 2 {
+		switch strings.ToLower(fields[1]) {
+		case "hz":
+			f = f / 1e6
+		case "khz":
+			f = f / 1e3
+		case "mhz":
+			// good
+		case "ghz":
+			f = f * 1e3
+		default:
+			return 0, fmt.Errorf("unknown unit %s", fields[1])
+		}
+	}
+	return f, nil
+}
+
+var ctcssTones = []float64{
+	67.0, 69.3, 71.9, 74.4, 77.0, 79.7, 82.5, 85.4, 88.5, 91.5, 94.8, 97.4, 100.0, 103.5, 107.2,
+	110.9, 114.8, 118.8, 123.0, 127.3, 131.8, 136.5, 141.3, 146.2, 150.0, 151.4, 156.7, 159.8,
+	162.2, 165.5, 167.9, 171.3, 173.8, 177.3, 179.9, 183.5, 186.2, 189.9, 192.8, 196.6, 199.5,
+	203.5, 206.5, 210.7, 218.1, 225.7, 229.1, 233.6, 241.8, 250.3, 254.1,
+}
+
+var dcsCodes = []int{
+	23, 25, 26, 31, 32, 36, 43, 47, 51, 53, 54, 65, 71, 72, 73, 74, 114, 115, 116, 122, 125, 131,
+	132, 134, 143, 145, 152, 155, 156, 162, 165, 172, 174, 205, 212, 223, 225, 226, 243, 244, 245,
+	246, 251, 252, 255, 261, 263, 265, 266, 271, 274, 306, 311, 315, 325, 331, 332, 343, 346, 351,
+	356, 364, 365, 371, 411, 412, 413, 423, 431, 432, 445, 446, 452, 454, 455, 462, 464, 465, 466,
+	503, 506, 516, 523, 526, 532, 546, 565, 606, 612, 624, 627, 631, 632, 654, 662, 664, 703, 712,
+	723, 731, 732, 734, 743, 754,
+}